@@ -30,7 +30,7 @@ func Foreach[T any](arr []T, f func(*T)) {
 
 // Fill generates an array of length len, where arr[i] = t.
 func Fill[T any](len int, t T) []T {
-	arr := make([]T, 0, len)
+	arr := make([]T, len)
 	inplace.Fill(arr, t)
 	return arr
 }
@@ -43,6 +43,14 @@ func Generate[T any](len int, f func() T) []T {
 	return arr
 }
 
+// GenerateIndexed generates an array of length len, where arr[i] = f(i).
+// The function will be called in sequential order.
+func GenerateIndexed[T any](len int, f func(int) T) []T {
+	arr := make([]T, len)
+	inplace.GenerateIndexed(arr, f)
+	return arr
+}
+
 // Generate2D generates a 2D array of lengths n x m, where arr[i][j] = f()
 // The function will be called in sequential order.
 func Generate2D[T any](n, m int, f func() T) [][]T {
@@ -75,6 +83,43 @@ func Reduce[T, O any](arr []T, fold func(O, T) O, init O) O {
 	return o
 }
 
+// InclusiveScan []T->[]O applies the function fold:OxT->O cummulatively,
+// starting with the initial value init, and returns every intermediate
+// result. Item i of the output is equivalent to:
+//
+//	fold(fold(...fold(init, arr[0]), ..., arr[i-1]), arr[i])
+//
+// Example use: cumulative sum
+//
+//	InclusiveScan([]int{1, 2, 3}, utils.Add[int], 0) // Returns [1, 3, 6]
+//
+// Complexity is O(|arr|).
+func InclusiveScan[T, O any](arr []T, fold func(O, T) O, init O) []O {
+	o := make([]O, len(arr))
+	inplace.InclusiveScan(o, arr, fold, init)
+	return o
+}
+
+// ExclusiveScan []T->[]O is the same as InclusiveScan, except that item i of
+// the output does not include arr[i]. Hence, the first item is init:
+//
+//	fold(fold(...fold(init, arr[0]), ..., arr[i-2]), arr[i-1])
+//
+// Example use: offsets of consecutive buckets given their sizes
+//
+//	ExclusiveScan([]int{1, 2, 3}, utils.Add[int], 0) // Returns [0, 1, 3]
+//
+// Complexity is O(|arr|).
+func ExclusiveScan[T, O any](arr []T, fold func(O, T) O, init O) []O {
+	if len(arr) == 0 {
+		return []O{}
+	}
+	o := make([]O, len(arr))
+	o[0] = init
+	inplace.InclusiveScan(o[1:], arr[:len(arr)-1], fold, init)
+	return o
+}
+
 // MapReduce maps with the unary operator T->M, producing an
 // intermediate array []M that is then reduced with fold:
 // OxM->O.
@@ -195,8 +240,15 @@ func First[T any](arr []T, comp utils.Comparator[T]) (acc T) {
 //
 //	FirstN(arr, 3, func(x, y int) bool { return x>y })
 //
-// Complexity is O(n·|arr|).
+// The strategy depends on n: small values insert every item into a sorted
+// list, medium values keep the best items in a heap, and large values select
+// them in a copy of the array with PartialSort.
+//
+// Complexity is O(|arr|·log(n)).
 func FirstN[T any](arr []T, n uint, comp utils.Comparator[T]) []T {
+	if n == 0 {
+		return []T{}
+	}
 	if uint(len(arr)) <= n {
 		n = uint(len(arr))
 		acc := make([]T, n)
@@ -204,6 +256,14 @@ func FirstN[T any](arr []T, n uint, comp utils.Comparator[T]) []T {
 		Sort(acc, comp)
 		return acc
 	}
+
+	if n > firstNInsertionThreshold {
+		if n <= uint(len(arr))/firstNHeapRatio {
+			return firstNWithHeap(arr, int(n), comp)
+		}
+		return firstNWithSelection(arr, int(n), comp)
+	}
+
 	acc := make([]T, n)
 	copy(acc, arr[:n])
 	Sort(acc, comp)
@@ -215,6 +275,15 @@ func FirstN[T any](arr []T, n uint, comp utils.Comparator[T]) []T {
 	return acc
 }
 
+const (
+	// firstNInsertionThreshold is the largest n for which FirstN uses InsertSorted.
+	firstNInsertionThreshold = 16
+
+	// firstNHeapRatio is the smallest ratio |arr|/n for which FirstN uses a heap
+	// rather than selection.
+	firstNHeapRatio = 8
+)
+
 // InsertSorted takes a list arr sorted according to comp and emplaces
 // x in the position that keeps the list sorted. After this, the last
 // element is dropped from the list. Note that if x were to be last,
@@ -376,6 +445,52 @@ func Partition[T any](slice []T, predicate func(t T) bool) (p int) {
 	return p
 }
 
+// StablePartition rearranges a list like Partition, but the items on either
+// side keep their original relative order. It returns the number of items
+// that fulfil the predicate.
+//
+// Example: partition smaller than 3:
+//
+//	arr := []int{5, 1, 4, 2, 0}
+//	j := StablePartition(arr, func(x int) bool { return x<3 })
+//	// arr = [1, 2, 0, 5, 4], j = 3
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr|).
+func StablePartition[T any](arr []T, pred utils.Predicate[T]) int {
+	rejected := make([]T, 0, len(arr))
+	p := 0
+	for _, a := range arr {
+		if pred(a) {
+			arr[p] = a
+			p++
+			continue
+		}
+		rejected = append(rejected, a)
+	}
+	copy(arr[p:], rejected)
+	return p
+}
+
+// Filter returns a list with the items in arr that fulfil the predicate,
+// in their original order.
+//
+// Example: keep only even values:
+//
+//	Filter(arr, func(x int) bool { return x%2 == 0 })
+//
+// Complexity is O(|arr|).
+func Filter[T any](arr []T, pred utils.Predicate[T]) []T {
+	o := []T{}
+	for _, a := range arr {
+		if pred(a) {
+			o = append(o, a)
+		}
+	}
+	return o
+}
+
 // Insert returns an array {arr[:position], value, arr[position:]}.
 // Original array becomes invalidated. Usage:
 //
@@ -24,6 +24,14 @@ func TestReduce(t *testing.T) {
 	t.Run("int64", testReduce[int64])
 }
 
+func TestScan(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testScan[int])
+	t.Run("int8", testScan[int8])
+	t.Run("int32", testScan[int32])
+	t.Run("int64", testScan[int64])
+}
+
 func TestMapReduce(t *testing.T) {
 	t.Parallel()
 	t.Run("int", testMapReduce[int])
@@ -104,6 +112,14 @@ func TestPartition(t *testing.T) {
 	t.Run("int64", testPartition[int64])
 }
 
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testFilter[int])
+	t.Run("int8", testFilter[int8])
+	t.Run("int32", testFilter[int32])
+	t.Run("int64", testFilter[int64])
+}
+
 func TestInsert(t *testing.T) {
 	t.Parallel()
 	t.Run("int", testInsert[int])
@@ -120,6 +136,19 @@ func TestRotate(t *testing.T) {
 	t.Run("int64", testRotate[int64])
 }
 
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []int{}, algo.Fill(0, 5))
+	require.Equal(t, []int{5, 5, 5}, algo.Fill(3, 5))
+
+	next := 0
+	require.Equal(t, []int{0, 1, 2}, algo.Generate(3, func() int { next++; return next - 1 }))
+	require.Equal(t, []int{0, 2, 4}, algo.GenerateIndexed(3, func(i int) int { return 2 * i }))
+	require.Equal(t, [][]int{{7, 7}}, algo.Generate2D(1, 2, func() int { return 7 }))
+	require.Equal(t, [][][]int{{{7}, {7}}}, algo.Generate3D(1, 2, 1, func() int { return 7 }))
+}
+
 func testMap[T utils.Signed](t *testing.T) { //nolint: thelper
 	t.Parallel()
 
@@ -167,6 +196,33 @@ func testReduce[T utils.Signed](t *testing.T) { //nolint: thelper // nolint: the
 	}
 }
 
+func testScan[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     []T
+		fold      func(T, T) T
+		init      T
+		inclusive []T
+		exclusive []T
+	}{
+		"empty sum":        {input: []T{}, fold: utils.Add[T], inclusive: []T{}, exclusive: []T{}},
+		"single sum":       {input: []T{5}, fold: utils.Add[T], init: 1, inclusive: []T{6}, exclusive: []T{1}},
+		"small sum":        {input: []T{1, 2, 3}, fold: utils.Add[T], inclusive: []T{1, 3, 6}, exclusive: []T{0, 1, 3}},
+		"small difference": {input: []T{1, 2, 3}, fold: utils.Sub[T], init: 10, inclusive: []T{9, 7, 4}, exclusive: []T{10, 9, 7}},
+		"normal max":       {input: []T{-8, 7, 0, 3, 9, -15}, fold: utils.Max[T], init: -10, inclusive: []T{-8, 7, 7, 7, 9, 9}, exclusive: []T{-10, -8, 7, 7, 7, 9}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.inclusive, algo.InclusiveScan(tc.input, tc.fold, tc.init), "Wrong inclusive scan")
+			require.Equal(t, tc.exclusive, algo.ExclusiveScan(tc.input, tc.fold, tc.init), "Wrong exclusive scan")
+		})
+	}
+}
+
 func testMapReduce[T utils.Signed](t *testing.T) { //nolint: thelper
 	t.Parallel()
 
@@ -497,3 +553,38 @@ func testRotate[T utils.Signed](t *testing.T) { //nolint: thelper
 		})
 	}
 }
+
+func testFilter[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	even := func(x T) bool { return int(x)%2 == 0 }
+	testCases := map[string]struct {
+		data     []T
+		pred     utils.Predicate[T]
+		accepted []T
+		rejected []T
+	}{
+		"empty":        {data: []T{}, pred: even, accepted: []T{}, rejected: []T{}},
+		"single, keep": {data: []T{4}, pred: even, accepted: []T{4}, rejected: []T{}},
+		"single, drop": {data: []T{3}, pred: even, accepted: []T{}, rejected: []T{3}},
+		"all":          {data: []T{2, 0, 8}, pred: even, accepted: []T{2, 0, 8}, rejected: []T{}},
+		"none":         {data: []T{1, 7, 3}, pred: even, accepted: []T{}, rejected: []T{1, 7, 3}},
+		"mixed":        {data: []T{5, 2, 7, 4, 1, 0, 6, 3}, pred: even, accepted: []T{2, 4, 0, 6}, rejected: []T{5, 7, 1, 3}},
+		"less than":    {data: []T{3, 13, 84, 6, 3}, pred: func(x T) bool { return x < 9 }, accepted: []T{3, 6, 3}, rejected: []T{13, 84}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.accepted, algo.Filter(tc.data, tc.pred), "Wrong filter")
+
+			arr := append([]T{}, tc.data...)
+			p := algo.StablePartition(arr, tc.pred)
+			require.Equal(t, len(tc.accepted), p, "Wrong partition point")
+			require.Equal(t, tc.accepted, arr[:p], "Wrong accepted items")
+			require.Equal(t, tc.rejected, arr[p:], "Wrong rejected items")
+		})
+	}
+}
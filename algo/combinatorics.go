@@ -0,0 +1,199 @@
+package algo
+
+import (
+	"iter"
+
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// The generators in this file do not store the sequences they generate.
+// Instead, they call a function with one item at a time, and stop as soon as
+// it returns false. Every one of them is available both as a function that
+// takes the callback (ForEachX) and as a lazy iterator (X).
+//
+// The slice passed to the callback is reused between calls, so it must be
+// copied if it is to be kept.
+
+// NextPermutation rearranges arr into the next permutation in lexicographic
+// order, according to the comparator comp. Equivalent items are not told
+// apart, so every distinct permutation is only visited once. It returns true
+// if there was a next permutation, and false otherwise, in which case arr is
+// rearranged into the first one, i.e. sorted.
+//
+// Complexity is O(|arr|).
+func NextPermutation[T any](arr []T, comp utils.Comparator[T]) bool {
+	// Find the longest suffix that is already the last permutation of its items.
+	i := len(arr) - 2
+	for i >= 0 && !comp(arr[i], arr[i+1]) {
+		i--
+	}
+	if i < 0 {
+		inplace.Reverse(arr)
+		return false
+	}
+
+	// Swap the item before it with the next larger item in the suffix, and
+	// rearrange the suffix into its first permutation.
+	j := len(arr) - 1
+	for !comp(arr[i], arr[j]) {
+		j--
+	}
+	arr[i], arr[j] = arr[j], arr[i]
+	inplace.Reverse(arr[i+1:])
+	return true
+}
+
+// PrevPermutation rearranges arr into the previous permutation in
+// lexicographic order, according to the comparator comp. Equivalent items are
+// not told apart, so every distinct permutation is only visited once. It
+// returns true if there was a previous permutation, and false otherwise, in
+// which case arr is rearranged into the last one, i.e. sorted backwards.
+//
+// Complexity is O(|arr|).
+func PrevPermutation[T any](arr []T, comp utils.Comparator[T]) bool {
+	return NextPermutation(arr, func(x, y T) bool { return comp(y, x) })
+}
+
+// ForEachPermutation calls f with every distinct permutation of arr in
+// lexicographic order, according to the comparator comp, until f returns
+// false. The array itself is not modified.
+//
+// Complexity is O(|arr|) per permutation.
+func ForEachPermutation[T any](arr []T, comp utils.Comparator[T], f func([]T) bool) {
+	perm := append([]T{}, arr...)
+	Sort(perm, comp)
+	for f(perm) {
+		if !NextPermutation(perm, comp) {
+			return
+		}
+	}
+}
+
+// Permutations is the same as ForEachPermutation, as a lazy iterator.
+func Permutations[T any](arr []T, comp utils.Comparator[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) { ForEachPermutation(arr, comp, yield) }
+}
+
+// ForEachCombination calls f with every combination of k items from arr,
+// until f returns false. Items are told apart by their position, and keep
+// their relative order. The combinations are generated in lexicographic order
+// of their positions. If k is larger than the length of arr, f is not called.
+// It panics if k is negative.
+//
+// Example: the combinations of 2 items of [a, b, c] are [a, b], [a, c], [b, c].
+//
+// Complexity is O(k) per combination.
+func ForEachCombination[T any](arr []T, k int, f func([]T) bool) {
+	if k < 0 {
+		panic("combination size must not be negative")
+	}
+	if k > len(arr) {
+		return
+	}
+
+	indices := GenerateIndexed(k, func(i int) int { return i })
+	combination := make([]T, k)
+	for {
+		inplace.Map(combination, indices, func(i int) T { return arr[i] })
+		if !f(combination) || !NextCombination(indices, len(arr)) {
+			return
+		}
+	}
+}
+
+// Combinations is the same as ForEachCombination, as a lazy iterator.
+func Combinations[T any](arr []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) { ForEachCombination(arr, k, yield) }
+}
+
+// NextCombination rearranges the increasing indices in the range [0, n) into
+// the next combination in lexicographic order. It returns true if there was a
+// next combination, and false otherwise, in which case indices is left as is.
+//
+// Complexity is O(|indices|).
+func NextCombination(indices []int, n int) bool {
+	k := len(indices)
+
+	// Find the last index that has not reached its maximum.
+	i := k - 1
+	for i >= 0 && indices[i] == n-k+i {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+
+	indices[i]++
+	for j := i + 1; j < k; j++ {
+		indices[j] = indices[j-1] + 1
+	}
+	return true
+}
+
+// ForEachSubset calls f with every subset of arr, until f returns false. Items
+// are told apart by their position, and keep their relative order. Subset i
+// contains item j if bit j of i is set, so the first one is empty and the last
+// one is arr itself. It panics if arr has more than 62 items.
+//
+// Complexity is O(|arr|) per subset.
+func ForEachSubset[T any](arr []T, f func([]T) bool) {
+	const maxLen = 62
+	if len(arr) > maxLen {
+		panic("too many items to enumerate their subsets")
+	}
+
+	subset := make([]T, 0, len(arr))
+	for mask := uint64(0); mask < 1<<len(arr); mask++ {
+		subset = subset[:0]
+		for j := range arr {
+			if mask&(1<<j) != 0 {
+				subset = append(subset, arr[j])
+			}
+		}
+		if !f(subset) {
+			return
+		}
+	}
+}
+
+// PowerSet is the same as ForEachSubset, as a lazy iterator.
+func PowerSet[T any](arr []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) { ForEachSubset(arr, yield) }
+}
+
+// ForEachProduct calls f with every tuple in the cartesian product of the
+// lists, until f returns false. Item i of every tuple is taken from list i.
+// Tuples are generated in lexicographic order, i.e. the last list varies the
+// fastest. If any list is empty, f is not called. If there are no lists, f is
+// called once with an empty tuple.
+//
+// Complexity is O(|lists|) per tuple.
+func ForEachProduct[T any](f func([]T) bool, lists ...[]T) {
+	for _, l := range lists {
+		if len(l) == 0 {
+			return
+		}
+	}
+
+	indices := make([]int, len(lists))
+	tuple := Map(lists, func(l []T) T { return l[0] })
+	for f(tuple) {
+		// Advance the indices like an odometer.
+		i := len(lists) - 1
+		for ; i >= 0 && indices[i] == len(lists[i])-1; i-- {
+			indices[i] = 0
+			tuple[i] = lists[i][0]
+		}
+		if i < 0 {
+			return
+		}
+		indices[i]++
+		tuple[i] = lists[i][indices[i]]
+	}
+}
+
+// CartesianProduct is the same as ForEachProduct, as a lazy iterator.
+func CartesianProduct[T any](lists ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) { ForEachProduct(yield, lists...) }
+}
@@ -0,0 +1,167 @@
+package algo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPermutation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []int
+		want  [][]int
+	}{
+		"empty":      {input: []int{}, want: [][]int{{}}},
+		"single":     {input: []int{1}, want: [][]int{{1}}},
+		"distinct":   {input: []int{1, 2, 3}, want: [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}},
+		"duplicates": {input: []int{1, 1, 2}, want: [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}}},
+		"all equal":  {input: []int{7, 7, 7}, want: [][]int{{7, 7, 7}}},
+		"two pairs":  {input: []int{1, 1, 2, 2}, want: [][]int{{1, 1, 2, 2}, {1, 2, 1, 2}, {1, 2, 2, 1}, {2, 1, 1, 2}, {2, 1, 2, 1}, {2, 2, 1, 1}}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Forwards.
+			arr := append([]int{}, tc.input...)
+			got := [][]int{}
+			for ok := true; ok; ok = algo.NextPermutation(arr, utils.Lt[int]) {
+				got = append(got, append([]int{}, arr...))
+			}
+			require.Equal(t, tc.want, got, "Wrong NextPermutation")
+			require.Equal(t, tc.input, arr, "NextPermutation should wrap around to the first permutation")
+
+			// Backwards.
+			arr = append([]int{}, tc.want[len(tc.want)-1]...)
+			got = [][]int{}
+			for ok := true; ok; ok = algo.PrevPermutation(arr, utils.Lt[int]) {
+				got = append([][]int{append([]int{}, arr...)}, got...)
+			}
+			require.Equal(t, tc.want, got, "Wrong PrevPermutation")
+			require.Equal(t, tc.want[len(tc.want)-1], arr, "PrevPermutation should wrap around to the last permutation")
+
+			// Generators.
+			require.Equal(t, tc.want, collect(algo.Permutations(algo.Reverse(tc.input), utils.Lt[int])), "Wrong Permutations")
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	t.Parallel()
+
+	input := []string{"a", "b", "c", "d"}
+
+	testCases := map[string]struct {
+		k    int
+		want [][]string
+	}{
+		"none":     {k: 0, want: [][]string{{}}},
+		"single":   {k: 1, want: [][]string{{"a"}, {"b"}, {"c"}, {"d"}}},
+		"pairs":    {k: 2, want: [][]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}}},
+		"all":      {k: 4, want: [][]string{input}},
+		"too many": {k: 5, want: [][]string{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, collect(algo.Combinations(input, tc.k)))
+		})
+	}
+
+	require.Panics(t, func() { algo.ForEachCombination(input, -1, func([]string) bool { return true }) })
+}
+
+func TestPowerSet(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, [][]int{{}}, collect(algo.PowerSet([]int{})))
+	require.Equal(t, [][]int{{}, {1}, {2}, {1, 2}, {3}, {1, 3}, {2, 3}, {1, 2, 3}}, collect(algo.PowerSet([]int{1, 2, 3})))
+	require.Panics(t, func() { algo.ForEachSubset(make([]int, 63), func([]int) bool { return true }) })
+}
+
+func TestCartesianProduct(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		lists [][]int
+		want  [][]int
+	}{
+		"no lists":   {lists: [][]int{}, want: [][]int{{}}},
+		"one list":   {lists: [][]int{{1, 2}}, want: [][]int{{1}, {2}}},
+		"two lists":  {lists: [][]int{{1, 2}, {3, 4, 5}}, want: [][]int{{1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}}},
+		"singletons": {lists: [][]int{{1}, {2}, {3}}, want: [][]int{{1, 2, 3}}},
+		"empty list": {lists: [][]int{{1, 2}, {}, {3}}, want: [][]int{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, collect(algo.CartesianProduct(tc.lists...)))
+		})
+	}
+}
+
+func TestGeneratorsStopEarly(t *testing.T) {
+	t.Parallel()
+
+	input := algo.Generate(10, counter[int](0, 1))
+
+	generators := map[string]func(func([]int) bool){
+		"ForEachPermutation": func(f func([]int) bool) { algo.ForEachPermutation(input, utils.Lt[int], f) },
+		"ForEachCombination": func(f func([]int) bool) { algo.ForEachCombination(input, 5, f) },
+		"ForEachSubset":      func(f func([]int) bool) { algo.ForEachSubset(input, f) },
+		"ForEachProduct":     func(f func([]int) bool) { algo.ForEachProduct(f, input, input, input) },
+	}
+
+	for name, generate := range generators {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			generate(func([]int) bool {
+				calls++
+				return calls < 3
+			})
+			require.Equal(t, 3, calls, "Generator did not stop when asked to")
+		})
+	}
+}
+
+func TestCombinationCounts(t *testing.T) {
+	t.Parallel()
+
+	for n := 0; n < 8; n++ {
+		for k := 0; k <= n; k++ {
+			t.Run(fmt.Sprintf("%d choose %d", n, k), func(t *testing.T) {
+				t.Parallel()
+
+				input := algo.Generate(n, counter[int](0, 1))
+				got := collect(algo.Combinations(input, k))
+
+				// C(n, k) = n·(n-1)·...·(n-k+1) / k!
+				want := 1
+				for i := 0; i < k; i++ {
+					want = want * (n - i) / (i + 1)
+				}
+				require.Len(t, got, want)
+			})
+		}
+	}
+}
+
+// collect copies every item yielded by the generator, since they are reused.
+func collect[T any](seq func(func([]T) bool)) [][]T {
+	out := [][]T{}
+	seq(func(x []T) bool {
+		out = append(out, append([]T{}, x...))
+		return true
+	})
+	return out
+}
@@ -0,0 +1,74 @@
+package algo
+
+import (
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// GroupBy classifies the items in arr according to their key. Items keep
+// their relative order within every group.
+//
+// Complexity is O(|arr|).
+func GroupBy[T any, K comparable](arr []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, t := range arr {
+		k := key(t)
+		groups[k] = append(groups[k], t)
+	}
+	return groups
+}
+
+// CountBy counts how many items in arr have every key.
+//
+// Complexity is O(|arr|).
+func CountBy[T any, K comparable](arr []T, key func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, t := range arr {
+		counts[key(t)]++
+	}
+	return counts
+}
+
+// Histogram counts how many items in arr fall into every bin. Bin i contains
+// the items in the range [edges[i], edges[i+1]), except for the last one,
+// which also contains edges[len(edges)-1]. Items outside all bins, including
+// NaNs, are not counted.
+//
+// The edges must be strictly increasing, and there must be at least two of
+// them. Otherwise, it panics.
+//
+// Complexity is O(|arr|·log|edges|).
+func Histogram[T utils.Number](arr []T, edges []T) []int {
+	validateEdges(edges)
+
+	counts := make([]int, len(edges)-1)
+	for _, t := range arr {
+		if bin := histogramBin(edges, t); bin >= 0 {
+			counts[bin]++
+		}
+	}
+	return counts
+}
+
+// histogramBin returns the index of the histogram bin that contains x, or -1
+// if none does.
+func histogramBin[T utils.Number](edges []T, x T) int {
+	if x == edges[len(edges)-1] {
+		return len(edges) - 2
+	}
+	bin := UpperBound(edges, x, utils.Lt[T]) - 1
+	if bin < 0 || bin >= len(edges)-1 {
+		return -1
+	}
+	return bin
+}
+
+func validateEdges[T utils.Number](edges []T) {
+	if len(edges) < 2 {
+		panic("histograms need at least two bin edges")
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i-1] < edges[i]) {
+			panic("histogram bin edges must be strictly increasing")
+		}
+	}
+}
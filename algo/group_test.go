@@ -0,0 +1,75 @@
+package algo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupBy(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"apple", "bee", "avocado", "cat", "banana", "ant"}
+	first := func(s string) byte { return s[0] }
+
+	require.Equal(t, map[byte][]string{
+		'a': {"apple", "avocado", "ant"},
+		'b': {"bee", "banana"},
+		'c': {"cat"},
+	}, algo.GroupBy(words, first))
+
+	require.Equal(t, map[byte]int{'a': 3, 'b': 2, 'c': 1}, algo.CountBy(words, first))
+
+	require.Empty(t, algo.GroupBy([]string{}, first))
+	require.Empty(t, algo.CountBy([]string{}, first))
+}
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testHistogram[int])
+	t.Run("int8", testHistogram[int8])
+	t.Run("uint", testHistogram[uint])
+	t.Run("float32", testHistogram[float32])
+	t.Run("float64", testHistogram[float64])
+
+	nan := math.NaN()
+	require.Equal(t, []int{1, 0}, algo.Histogram([]float64{nan, 0.5, math.Inf(1)}, []float64{0, 1, 2}), "NaNs and infinities should not be counted")
+}
+
+func testHistogram[T utils.Number](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []T
+		edges []T
+		want  []int
+		panic bool
+	}{
+		"empty":            {input: []T{}, edges: []T{0, 10}, want: []int{0}},
+		"single bin":       {input: []T{0, 5, 10, 11}, edges: []T{0, 10}, want: []int{3}},
+		"many bins":        {input: []T{1, 2, 3, 4, 5, 6, 7, 8, 9}, edges: []T{2, 4, 5, 8}, want: []int{2, 1, 4}},
+		"right-most edge":  {input: []T{8, 8, 7}, edges: []T{2, 4, 5, 8}, want: []int{0, 0, 3}},
+		"outside":          {input: []T{0, 1, 9, 100}, edges: []T{2, 4, 5, 8}, want: []int{0, 0, 0}},
+		"too few edges":    {input: []T{1}, edges: []T{1}, panic: true},
+		"unsorted edges":   {input: []T{1}, edges: []T{1, 3, 2}, panic: true},
+		"repeated edges":   {input: []T{1}, edges: []T{1, 2, 2, 3}, panic: true},
+		"no edges":         {input: []T{}, edges: []T{}, panic: true},
+		"empty, many bins": {input: nil, edges: []T{1, 2, 3}, want: []int{0, 0}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.panic {
+				require.Panics(t, func() { algo.Histogram(tc.input, tc.edges) })
+				return
+			}
+			require.Equal(t, tc.want, algo.Histogram(tc.input, tc.edges))
+		})
+	}
+}
@@ -60,3 +60,83 @@ func Clamp[T utils.Number](lo, x, hi T) T {
 	}
 	return utils.Max(lo, utils.Min(x, hi))
 }
+
+// Binomial returns the binomial coefficient C(n, k), i.e. the number of ways
+// to choose k items out of n. It is zero if k is negative or larger than n.
+// The boolean is false if the result does not fit in T. It panics if n is
+// negative.
+//
+// Complexity is O(k).
+func Binomial[T constraints.Integer](n, k T) (T, bool) {
+	if n < 0 {
+		panic("binomial coefficients need a non-negative n")
+	}
+	if k < 0 || k > n {
+		return 0, true
+	}
+
+	k = utils.Min(k, n-k)
+	var c T = 1
+	for i := T(1); i <= k; i++ {
+		// The next coefficient is C(n-k+i, i) = c·(n-k+i)/i. Dividing by the
+		// GCD first keeps the intermediate product as small as possible.
+		g := GCD(c, i)
+		m := (n - k + i) / (i / g)
+		c /= g
+		if mulOverflows(c, m) {
+			return 0, false
+		}
+		c *= m
+	}
+	return c, true
+}
+
+// Multinomial returns the multinomial coefficient of the counts, i.e. the
+// number of distinct ways to arrange count[0] items of one kind, count[1]
+// of another, and so on. The boolean is false if the result does not fit
+// in T. It panics if any count is negative.
+//
+// Complexity is O(sum of counts).
+func Multinomial[T constraints.Integer](counts ...T) (T, bool) {
+	var total T
+	var result T = 1
+	for _, k := range counts {
+		if k < 0 {
+			panic("multinomial coefficients need non-negative counts")
+		}
+		if total > maxInteger[T]()-k {
+			// With two non-zero counts, the result is at least their sum.
+			return 0, false
+		}
+		total += k
+
+		// Arrange the items of this kind among all the ones so far.
+		c, ok := Binomial(total, k)
+		if !ok || mulOverflows(result, c) {
+			return 0, false
+		}
+		result *= c
+	}
+	return result, true
+}
+
+// mulOverflows returns true if the product of two non-negative integers
+// does not fit in their type.
+func mulOverflows[T constraints.Integer](a, b T) bool {
+	return b != 0 && a > maxInteger[T]()/b
+}
+
+// addOverflows returns true if the sum of two non-negative integers does
+// not fit in their type.
+func addOverflows[T constraints.Integer](a, b T) bool {
+	return a > maxInteger[T]()-b
+}
+
+// maxInteger returns the largest value of an integer type.
+func maxInteger[T constraints.Integer]() T {
+	var zero T
+	if ^zero > 0 {
+		return ^zero // Unsigned.
+	}
+	return ^(T(1) << (bitSize[T]() - 1))
+}
@@ -2,6 +2,7 @@ package algo_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/EduardGomezEscandell/algo/algo"
@@ -44,6 +45,29 @@ func TestLCM(t *testing.T) {
 	t.Run("uint64", testLCM[uint64])
 }
 
+func TestBinomial(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testBinomial[int])
+	t.Run("int8", testBinomial[int8])
+	t.Run("int32", testBinomial[int32])
+	t.Run("int64", testBinomial[int64])
+
+	t.Run("uint", testBinomial[uint])
+	t.Run("uint8", testBinomial[uint8])
+	t.Run("uint32", testBinomial[uint32])
+	t.Run("uint64", testBinomial[uint64])
+}
+
+func TestMultinomial(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testMultinomial[int])
+	t.Run("int8", testMultinomial[int8])
+	t.Run("int64", testMultinomial[int64])
+
+	t.Run("uint8", testMultinomial[uint8])
+	t.Run("uint64", testMultinomial[uint64])
+}
+
 func TestClamp(t *testing.T) {
 	t.Parallel()
 	t.Run("int", testClamp[int])
@@ -151,3 +175,88 @@ func TestCount(t *testing.T) {
 	require.Equal(t, 2, algo.Count(1, true))
 	require.Equal(t, 1, algo.Count(1, false))
 }
+
+func testBinomial[T constraints.Integer](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	for n := 0; n <= 70; n++ {
+		for k := -1; k <= n+1; k++ {
+			want := big.NewInt(0)
+			if k >= 0 && k <= n {
+				want.Binomial(int64(n), int64(k))
+			}
+			got, ok := algo.Binomial(T(n), T(k))
+
+			w, fits := fitsInteger[T](want)
+			require.Equal(t, fits, ok, "Binomial(%d, %d) = %v should fit: %t", n, k, want, fits)
+			if fits {
+				require.Equal(t, w, got, "Wrong Binomial(%d, %d)", n, k)
+			}
+		}
+	}
+
+	if minusOne := T(0) - 1; minusOne < 0 {
+		require.Panics(t, func() { algo.Binomial(minusOne, 0) }, "Binomial should panic with negative n")
+	}
+}
+
+func testMultinomial[T constraints.Integer](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := [][]int{
+		{},
+		{0},
+		{5},
+		{0, 0, 0},
+		{1, 1},
+		{2, 1, 1},
+		{3, 3},
+		{4, 0, 4},
+		{1, 1, 1, 1, 1},
+		{2, 2, 2, 2},
+		{10, 10, 10},
+		{20, 20, 20},
+		{100, 1},
+		{40, 40},
+	}
+
+	for _, counts := range testCases {
+		// The product of the binomials of the prefix sums.
+		want := big.NewInt(1)
+		total := int64(0)
+		typed := make([]T, len(counts))
+		for i, c := range counts {
+			total += int64(c)
+			want.Mul(want, big.NewInt(0).Binomial(total, int64(c)))
+			typed[i] = T(c)
+		}
+
+		got, ok := algo.Multinomial(typed...)
+		w, fits := fitsInteger[T](want)
+		require.Equal(t, fits, ok, "Multinomial%v = %v should fit: %t", counts, want, fits)
+		if fits {
+			require.Equal(t, w, got, "Wrong Multinomial%v", counts)
+		}
+	}
+
+	// The sum of the counts overflows.
+	var maxT T = 1
+	for maxT*2+1 > maxT {
+		maxT = maxT*2 + 1
+	}
+	_, ok := algo.Multinomial(maxT, 1)
+	require.False(t, ok, "Multinomial should detect that the sum overflows")
+	got, ok := algo.Multinomial(0, maxT, 0)
+	require.True(t, ok, "Multinomial with a single non-zero count should fit")
+	require.Equal(t, T(1), got, "Wrong Multinomial with a single non-zero count")
+}
+
+// fitsInteger converts a non-negative big integer into T. The boolean is false
+// if it does not fit.
+func fitsInteger[T constraints.Integer](v *big.Int) (T, bool) {
+	if !v.IsUint64() {
+		return 0, false
+	}
+	t := T(v.Uint64())
+	return t, t >= 0 && uint64(t) == v.Uint64()
+}
@@ -0,0 +1,241 @@
+package algo
+
+import (
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// RankPermutation returns the lexicographic rank of a permutation of the
+// integers in [0, n), where n is its length, i.e. the number of times
+// NextPermutation must be called on [0, 1, ..., n-1] to reach it. It is
+// computed from its Lehmer code: the number of items after every item that
+// are smaller than it. It panics if perm is not a permutation, or if the
+// rank does not fit in an int.
+//
+// Complexity is O(n²).
+func RankPermutation(perm []int) int {
+	validatePermutation(perm)
+
+	rank := 0
+	for i, p := range perm {
+		smaller := 0
+		for _, q := range perm[i+1:] {
+			smaller = Count(smaller, q < p)
+		}
+
+		// Horner's method on the factorial number system.
+		if mulOverflows(rank, len(perm)-i) {
+			panic("rank does not fit in an int")
+		}
+		rank *= len(perm) - i
+		if addOverflows(rank, smaller) {
+			panic("rank does not fit in an int")
+		}
+		rank += smaller
+	}
+	return rank
+}
+
+// UnrankPermutation returns the permutation of the integers in [0, n) with
+// the given lexicographic rank. It is the inverse of RankPermutation. It
+// panics if n is negative, or if the rank is not in [0, n!).
+//
+// Complexity is O(n²).
+func UnrankPermutation(n, rank int) []int {
+	if n < 0 {
+		panic("permutation length must not be negative")
+	}
+	validateRank(rank)
+
+	// The Lehmer code is the rank in the factorial number system.
+	lehmer := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		lehmer[i] = rank % (n - i)
+		rank /= n - i
+	}
+	if rank != 0 {
+		panic("rank out of range")
+	}
+
+	remaining := make([]int, n)
+	inplace.GenerateIndexed(remaining, func(i int) int { return i })
+
+	perm := make([]int, n)
+	for i, l := range lehmer {
+		perm[i] = remaining[l]
+		remaining = append(remaining[:l], remaining[l+1:]...)
+	}
+	return perm
+}
+
+// RankCombination returns the lexicographic rank of a combination of
+// k = len(indices) increasing indices out of [0, n), i.e. its position in
+// the order in which NextCombination generates them. The rank is a sum of
+// binomial coefficients, as in the combinatorial number system. It panics if
+// the indices are not increasing or not in [0, n), or if the rank does not
+// fit in an int.
+//
+// Complexity is O(n·k).
+func RankCombination(indices []int, n int) int {
+	k := len(indices)
+	for i, idx := range indices {
+		if idx < 0 || idx >= n || (i > 0 && idx <= indices[i-1]) {
+			panic("indices must be increasing and in [0, n)")
+		}
+	}
+
+	rank := 0
+	next := 0
+	for i, idx := range indices {
+		// Skip all the combinations that start with a smaller index.
+		for ; next < idx; next++ {
+			count, ok := Binomial(n-next-1, k-i-1)
+			if !ok || addOverflows(rank, count) {
+				panic("rank does not fit in an int")
+			}
+			rank += count
+		}
+		next = idx + 1
+	}
+	return rank
+}
+
+// UnrankCombination returns the combination of k increasing indices out of
+// [0, n) with the given lexicographic rank. It is the inverse of
+// RankCombination. It panics if k is not in [0, n], or if the rank is not in
+// [0, C(n, k)).
+//
+// Complexity is O(n·k).
+func UnrankCombination(n, k, rank int) []int {
+	if k < 0 || k > n {
+		panic("k must be in [0, n]")
+	}
+	validateRank(rank)
+	if k == 0 && rank != 0 {
+		panic("rank out of range")
+	}
+
+	indices := make([]int, k)
+	next := 0
+	for i := range indices {
+		// Skip all the combinations that start with a smaller index. Counts
+		// that do not fit in an int are larger than any rank.
+		for {
+			if next > n-k+i {
+				panic("rank out of range")
+			}
+			count, ok := Binomial(n-next-1, k-i-1)
+			if !ok || rank < count {
+				break
+			}
+			rank -= count
+			next++
+		}
+		indices[i] = next
+		next++
+	}
+	return indices
+}
+
+// RankMultisetPermutation returns the lexicographic rank of perm among the
+// distinct permutations of its items according to comp, i.e. the number of
+// times NextPermutation must be called on its sorted items to reach it, and
+// its position in the order in which ForEachPermutation generates them. It
+// panics if the rank does not fit in an int.
+//
+// Complexity is O(n²·d), where d is the number of distinct items.
+func RankMultisetPermutation[T any](perm []T, comp utils.Comparator[T]) int {
+	values, counts := multiset(perm, comp)
+
+	rank := 0
+	for _, x := range perm {
+		v := LowerBound(values, x, comp)
+
+		// Skip all the permutations that continue with a smaller item.
+		for u := 0; u < v; u++ {
+			if counts[u] == 0 {
+				continue
+			}
+			counts[u]--
+			count, ok := Multinomial(counts...)
+			counts[u]++
+			if !ok || addOverflows(rank, count) {
+				panic("rank does not fit in an int")
+			}
+			rank += count
+		}
+		counts[v]--
+	}
+	return rank
+}
+
+// UnrankMultisetPermutation returns the distinct permutation of the items
+// with the given lexicographic rank according to comp. The order of items
+// does not matter. It is the inverse of RankMultisetPermutation. It panics
+// if the rank is not in [0, P), where P is the number of distinct
+// permutations.
+//
+// Complexity is O(n²·d), where d is the number of distinct items.
+func UnrankMultisetPermutation[T any](items []T, rank int, comp utils.Comparator[T]) []T {
+	validateRank(rank)
+	values, counts := multiset(items, comp)
+
+	perm := make([]T, 0, len(items))
+	for range items {
+		chosen := -1
+		for u := range values {
+			if counts[u] == 0 {
+				continue
+			}
+			// Counts that do not fit in an int are larger than any rank.
+			counts[u]--
+			count, ok := Multinomial(counts...)
+			if !ok || rank < count {
+				chosen = u
+				break
+			}
+			counts[u]++
+			rank -= count
+		}
+		if chosen < 0 {
+			panic("rank out of range")
+		}
+		perm = append(perm, values[chosen])
+	}
+	if rank != 0 {
+		panic("rank out of range")
+	}
+	return perm
+}
+
+// multiset returns the sorted distinct items in arr, and how many times each
+// of them appears.
+func multiset[T any](arr []T, comp utils.Comparator[T]) (values []T, counts []int) {
+	sorted := append([]T{}, arr...)
+	Sort(sorted, comp)
+
+	for i, x := range sorted {
+		if i == 0 || comp(sorted[i-1], x) {
+			values = append(values, x)
+			counts = append(counts, 0)
+		}
+		counts[len(counts)-1]++
+	}
+	return values, counts
+}
+
+func validatePermutation(perm []int) {
+	seen := make([]bool, len(perm))
+	for _, p := range perm {
+		if p < 0 || p >= len(perm) || seen[p] {
+			panic("not a permutation of [0, n)")
+		}
+		seen[p] = true
+	}
+}
+
+func validateRank(rank int) {
+	if rank < 0 {
+		panic("rank must not be negative")
+	}
+}
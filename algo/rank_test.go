@@ -0,0 +1,138 @@
+package algo_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankPermutation(t *testing.T) {
+	t.Parallel()
+
+	for n := 0; n <= 6; n++ {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			t.Parallel()
+
+			perm := algo.GenerateIndexed(n, func(i int) int { return i })
+			for rank := 0; ; rank++ {
+				require.Equal(t, rank, algo.RankPermutation(perm), "Wrong rank of %v", perm)
+				require.Equal(t, perm, algo.UnrankPermutation(n, rank), "Wrong permutation with rank %d", rank)
+				if !algo.NextPermutation(perm, utils.Lt[int]) {
+					require.Panics(t, func() { algo.UnrankPermutation(n, rank+1) }, "UnrankPermutation should panic with ranks past the last one")
+					break
+				}
+			}
+		})
+	}
+
+	// 20! - 1 is the largest rank that fits in 64 bits.
+	last := algo.GenerateIndexed(20, func(i int) int { return 19 - i })
+	require.Equal(t, 2432902008176639999, algo.RankPermutation(last), "Wrong rank of the last permutation")
+	require.Equal(t, last, algo.UnrankPermutation(20, 2432902008176639999), "Wrong last permutation")
+
+	// Unranking a large permutation.
+	perm := algo.UnrankPermutation(100, math.MaxInt)
+	require.Equal(t, math.MaxInt, algo.RankPermutation(perm), "Ranking should invert unranking")
+
+	require.Panics(t, func() { algo.RankPermutation(algo.GenerateIndexed(21, func(i int) int { return 20 - i })) }, "RankPermutation should panic on overflow")
+	require.Panics(t, func() { algo.RankPermutation([]int{0, 2}) }, "RankPermutation should panic with items out of range")
+	require.Panics(t, func() { algo.RankPermutation([]int{1, 1}) }, "RankPermutation should panic with repeated items")
+	require.Panics(t, func() { algo.UnrankPermutation(3, -1) }, "UnrankPermutation should panic with negative ranks")
+	require.Panics(t, func() { algo.UnrankPermutation(-1, 0) }, "UnrankPermutation should panic with negative lengths")
+}
+
+func TestRankCombination(t *testing.T) {
+	t.Parallel()
+
+	for n := 0; n <= 7; n++ {
+		for k := 0; k <= n; k++ {
+			t.Run(fmt.Sprintf("%d choose %d", n, k), func(t *testing.T) {
+				t.Parallel()
+
+				indices := algo.GenerateIndexed(k, func(i int) int { return i })
+				for rank := 0; ; rank++ {
+					require.Equal(t, rank, algo.RankCombination(indices, n), "Wrong rank of %v", indices)
+					require.Equal(t, indices, algo.UnrankCombination(n, k, rank), "Wrong combination with rank %d", rank)
+					if !algo.NextCombination(indices, n) {
+						require.Panics(t, func() { algo.UnrankCombination(n, k, rank+1) }, "UnrankCombination should panic with ranks past the last one")
+						break
+					}
+				}
+			})
+		}
+	}
+
+	// The number of combinations does not fit in an int, but the rank does.
+	indices := algo.UnrankCombination(100, 50, math.MaxInt)
+	require.Equal(t, math.MaxInt, algo.RankCombination(indices, 100), "Ranking should invert unranking")
+	require.Equal(t, 0, algo.RankCombination(algo.GenerateIndexed(50, func(i int) int { return i }), 100), "Wrong rank of the first combination")
+
+	require.Panics(t, func() { algo.RankCombination(algo.GenerateIndexed(50, func(i int) int { return 50 + i }), 100) }, "RankCombination should panic on overflow")
+	require.Panics(t, func() { algo.RankCombination([]int{1, 1}, 3) }, "RankCombination should panic with repeated indices")
+	require.Panics(t, func() { algo.RankCombination([]int{2, 1}, 3) }, "RankCombination should panic with decreasing indices")
+	require.Panics(t, func() { algo.RankCombination([]int{0, 3}, 3) }, "RankCombination should panic with indices out of range")
+	require.Panics(t, func() { algo.UnrankCombination(3, 4, 0) }, "UnrankCombination should panic with k larger than n")
+	require.Panics(t, func() { algo.UnrankCombination(3, 0, 1) }, "UnrankCombination should panic with ranks past the last one")
+	require.Panics(t, func() { algo.UnrankCombination(3, 1, -1) }, "UnrankCombination should panic with negative ranks")
+}
+
+func TestRankMultisetPermutation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		items []int
+	}{
+		"empty":      {items: []int{}},
+		"single":     {items: []int{4}},
+		"distinct":   {items: []int{3, 1, 2, 4}},
+		"duplicates": {items: []int{3, 1, 3, 2, 1}},
+		"all equal":  {items: []int{7, 7, 7}},
+		"two kinds":  {items: []int{0, 1, 0, 1, 0, 1, 1}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rank := 0
+			algo.ForEachPermutation(tc.items, utils.Lt[int], func(perm []int) bool {
+				require.Equal(t, rank, algo.RankMultisetPermutation(perm, utils.Lt[int]), "Wrong rank of %v", perm)
+				require.Equal(t, perm, algo.UnrankMultisetPermutation(tc.items, rank, utils.Lt[int]), "Wrong permutation with rank %d", rank)
+				rank++
+				return true
+			})
+
+			require.Panics(t, func() { algo.UnrankMultisetPermutation(tc.items, rank, utils.Lt[int]) }, "UnrankMultisetPermutation should panic with ranks past the last one")
+			require.Panics(t, func() { algo.UnrankMultisetPermutation(tc.items, -1, utils.Lt[int]) }, "UnrankMultisetPermutation should panic with negative ranks")
+		})
+	}
+
+	t.Run("descending", func(t *testing.T) {
+		t.Parallel()
+
+		items := []int{1, 2, 2, 3}
+		got := []int{}
+		algo.ForEachPermutation(items, utils.Gt[int], func(perm []int) bool {
+			got = append(got, algo.RankMultisetPermutation(perm, utils.Gt[int]))
+			return true
+		})
+		require.Equal(t, algo.GenerateIndexed(12, func(i int) int { return i }), got, "Ranks should follow the order of the comparator")
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		t.Parallel()
+
+		// There are C(80, 40) > 2⁶³ permutations, but this rank fits in an int.
+		items := algo.GenerateIndexed(80, func(i int) int { return i % 2 })
+		perm := algo.UnrankMultisetPermutation(items, math.MaxInt, utils.Lt[int])
+		require.Equal(t, math.MaxInt, algo.RankMultisetPermutation(perm, utils.Lt[int]), "Ranking should invert unranking")
+
+		last := append([]int{}, perm...)
+		algo.Sort(last, utils.Gt[int])
+		require.Panics(t, func() { algo.RankMultisetPermutation(last, utils.Lt[int]) }, "RankMultisetPermutation should panic on overflow")
+	})
+}
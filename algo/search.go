@@ -0,0 +1,126 @@
+package algo
+
+import (
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// LowerBound finds the first position in arr where val could be inserted
+// without breaking the ordering. That is, the index of the first element
+// that does not precede val. If all elements preceed val, the length of
+// the array is returned.
+//
+// The list is expected to have been sorted with the comparator 'comp'.
+//
+// Complexity is O(log|arr|).
+func LowerBound[T any](arr []T, val T, comp utils.Comparator[T]) int {
+	return PartitionPoint(arr, func(t T) bool { return comp(t, val) })
+}
+
+// UpperBound finds the last position in arr where val could be inserted
+// without breaking the ordering. That is, the index of the first element
+// that succeeds val. If no element succeeds val, the length of the array
+// is returned.
+//
+// The list is expected to have been sorted with the comparator 'comp'.
+//
+// Complexity is O(log|arr|).
+func UpperBound[T any](arr []T, val T, comp utils.Comparator[T]) int {
+	return PartitionPoint(arr, func(t T) bool { return !comp(val, t) })
+}
+
+// EqualRange finds the range [begin, end) of elements in arr that are
+// equivalent to val. Two items a,b are considered equivalent if both
+// comp(a,b) and comp(b,a) are false. If there are none, the range is
+// empty and begin is the position where val could be inserted.
+//
+// The list is expected to have been sorted with the comparator 'comp'.
+//
+// Complexity is O(log|arr|).
+func EqualRange[T any](arr []T, val T, comp utils.Comparator[T]) (begin, end int) {
+	begin = LowerBound(arr, val, comp)
+	end = begin + UpperBound(arr[begin:], val, comp)
+	return begin, end
+}
+
+// Contains returns true if arr contains an element equivalent to val. Two
+// items a,b are considered equivalent if both comp(a,b) and comp(b,a) are
+// false.
+//
+// The list is expected to have been sorted with the comparator 'comp'.
+//
+// Complexity is O(log|arr|).
+func Contains[T any](arr []T, val T, comp utils.Comparator[T]) bool {
+	i := LowerBound(arr, val, comp)
+	return i < len(arr) && !comp(val, arr[i])
+}
+
+// PartitionPoint finds the index of the first element in arr that does
+// not fulfil the predicate. If all of them do, the length of the array
+// is returned.
+//
+// The list is expected to have been partitioned by the predicate, such that
+//
+//	pred(arr[i]) is true <=> i < j
+//
+// for some j, like the output of Partition.
+//
+// Complexity is O(log|arr|).
+func PartitionPoint[T any](arr []T, pred utils.Predicate[T]) int {
+	return BinarySearchFunc(len(arr), func(i int) bool { return pred(arr[i]) })
+}
+
+// BinarySearchFunc finds the first index i in the range [0, n) for which
+// pred(i) is false. If there is none, n is returned.
+//
+// The predicate is expected to be true for a prefix of the range, and false
+// for the rest of it. It is only ever called with indices in the range.
+//
+// Complexity is O(log(n)).
+func BinarySearchFunc(n int, pred func(i int) bool) int {
+	lo, hi := 0, n
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1) // Avoids overflow.
+		if pred(mid) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// ExponentialSearch finds the same position as LowerBound, but it gallops
+// from the beginning of the array in steps of increasing size before doing
+// a binary search. Hence, it is faster when val is expected to be near the
+// beginning of a large array.
+//
+// The list is expected to have been sorted with the comparator 'comp'.
+//
+// Complexity is O(log(i)), where i is the returned index.
+func ExponentialSearch[T any](arr []T, val T, comp utils.Comparator[T]) int {
+	lo, hi := 0, 1
+	for hi <= len(arr) && comp(arr[hi-1], val) {
+		lo = hi
+		hi *= 2
+	}
+	hi = utils.Min(hi, len(arr))
+	return lo + LowerBound(arr[lo:hi], val, comp)
+}
+
+// ExponentialSearchFunc finds the first non-negative index i for which
+// pred(i) is false, over an unbounded range. It gallops in steps of
+// increasing size until it finds such an index, and then it does a
+// binary search.
+//
+// The predicate is expected to be true for a prefix of the range, and
+// false for the rest of it. The prefix must be finite.
+//
+// Complexity is O(log(i)), where i is the returned index.
+func ExponentialSearchFunc(pred func(i int) bool) int {
+	lo, hi := 0, 1
+	for pred(hi - 1) {
+		lo = hi
+		hi *= 2
+	}
+	return lo + BinarySearchFunc(hi-lo, func(i int) bool { return pred(lo + i) })
+}
@@ -0,0 +1,130 @@
+package algo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinarySearch(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testBinarySearch[int])
+	t.Run("int8", testBinarySearch[int8])
+	t.Run("int32", testBinarySearch[int32])
+	t.Run("int64", testBinarySearch[int64])
+}
+
+func TestPartitionPoint(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testPartitionPoint[int])
+	t.Run("int8", testPartitionPoint[int8])
+	t.Run("int32", testPartitionPoint[int32])
+	t.Run("int64", testPartitionPoint[int64])
+}
+
+func TestExponentialSearchFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		target int
+		want   int
+	}{
+		"zero":         {target: 0, want: 0},
+		"one":          {target: 1, want: 1},
+		"power of two": {target: 64, want: 8},
+		"in between":   {target: 50, want: 8},
+		"large":        {target: 1 << 40, want: 1 << 20},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Find the smallest integer whose square is not less than the target.
+			calls := 0
+			got := algo.ExponentialSearchFunc(func(i int) bool {
+				calls++
+				require.GreaterOrEqual(t, i, 0)
+				return i*i < tc.target
+			})
+			require.Equal(t, tc.want, got)
+			require.LessOrEqual(t, calls, 2*(bitLen(tc.want)+1), "Too many calls to the predicate")
+		})
+	}
+}
+
+func testBinarySearch[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	comparators := map[string]utils.Comparator[T]{
+		"less than":    utils.Lt[T],
+		"greater than": utils.Gt[T],
+	}
+
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	for name, comp := range comparators {
+		comp := comp
+		for i := 0; i < 100; i++ {
+			arr := algo.Generate(rng.Intn(40), func() T { return T(rng.Intn(20) - 10) })
+			algo.Sort(arr, comp)
+			val := T(rng.Intn(24) - 12)
+
+			t.Run(fmt.Sprintf("%s/%v in %v", name, val, arr), func(t *testing.T) {
+				t.Parallel()
+
+				wantLower := linearSearch(arr, func(x T) bool { return !comp(x, val) })
+				wantUpper := linearSearch(arr, func(x T) bool { return comp(val, x) })
+				wantContains := algo.FindIf(arr, func(x T) bool { return !comp(x, val) && !comp(val, x) }) >= 0
+
+				require.Equal(t, wantLower, algo.LowerBound(arr, val, comp), "Wrong lower bound")
+				require.Equal(t, wantUpper, algo.UpperBound(arr, val, comp), "Wrong upper bound")
+				require.Equal(t, wantContains, algo.Contains(arr, val, comp), "Wrong result for contains")
+				require.Equal(t, wantLower, algo.ExponentialSearch(arr, val, comp), "Wrong exponential search")
+
+				begin, end := algo.EqualRange(arr, val, comp)
+				require.Equal(t, wantLower, begin, "Wrong start of equal range")
+				require.Equal(t, wantUpper, end, "Wrong end of equal range")
+			})
+		}
+	}
+}
+
+func testPartitionPoint[T utils.Number](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	for i := 0; i < 100; i++ {
+		arr := algo.Generate(rng.Intn(40), func() T { return T(rng.Intn(20)) })
+		pivot := T(rng.Intn(22) - 1)
+		pred := func(x T) bool { return x < pivot }
+
+		t.Run(fmt.Sprintf("%v in %v", pivot, arr), func(t *testing.T) {
+			t.Parallel()
+
+			want := algo.Partition(arr, pred)
+			require.Equal(t, want, algo.PartitionPoint(arr, pred))
+			require.Equal(t, want, algo.BinarySearchFunc(len(arr), func(i int) bool { return pred(arr[i]) }))
+		})
+	}
+}
+
+// linearSearch returns the index of the first element that fulfils the predicate,
+// or the length of the array if there is none.
+func linearSearch[T any](arr []T, pred utils.Predicate[T]) int {
+	if i := algo.FindIf(arr, pred); i >= 0 {
+		return i
+	}
+	return len(arr)
+}
+
+func bitLen(x int) (n int) {
+	for ; x > 0; x >>= 1 {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,186 @@
+package algo
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/EduardGomezEscandell/algo/dstruct"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// NthElement rearranges a list such that the item at position n is the one
+// that would be there if the list were sorted according to comp. No item
+// before it succeeds it, and no item after it preceeds it. The order of the
+// items on either side is unspecified.
+//
+// Example: move the 4th smallest item to position 3:
+//
+//	NthElement(arr, 3, utils.Lt[int])
+//
+// It uses introselect: a quickselect that falls back to sorting when the
+// partitions are too unbalanced. It panics if n is out of range.
+//
+// Complexity is O(|arr|) on average, and O(|arr|·log(|arr|)) in the worst case.
+func NthElement[T any](arr []T, n int, comp utils.Comparator[T]) {
+	if n < 0 || n >= len(arr) {
+		panic(fmt.Errorf("index %d out of range [0, %d)", n, len(arr)))
+	}
+
+	depth := 2 * bits.Len(uint(len(arr)))
+	lo, hi := 0, len(arr)
+	for hi-lo > insertionSortThreshold {
+		if depth == 0 {
+			Sort(arr[lo:hi], comp)
+			return
+		}
+		depth--
+
+		lt, gt := partition3(arr[lo:hi], medianOfThree(arr[lo:hi], comp), comp)
+		switch {
+		case n < lo+lt:
+			hi = lo + lt
+		case n >= lo+gt:
+			lo += gt
+		default:
+			return // Item n is equivalent to the pivot.
+		}
+	}
+	insertionSort(arr[lo:hi], comp)
+}
+
+// PartialSort rearranges a list such that its first k items are the ones that
+// would be there if the list were sorted according to comp, in the same order.
+// The order of the rest of the items is unspecified. If k is larger than the
+// length of the list, the whole list is sorted.
+//
+// Example: sort the 3 largest items to the front:
+//
+//	PartialSort(arr, 3, utils.Gt[int])
+//
+// Complexity is O(|arr| + k·log(k)) on average.
+func PartialSort[T any](arr []T, k int, comp utils.Comparator[T]) {
+	if k < 0 {
+		panic(fmt.Errorf("negative number of items: %d", k))
+	}
+	if k >= len(arr) {
+		Sort(arr, comp)
+		return
+	}
+	if k == 0 {
+		return
+	}
+	NthElement(arr, k-1, comp)
+	Sort(arr[:k-1], comp)
+}
+
+// Median returns the item that would be in the middle of the list if it were
+// sorted according to comp. If the length of the list is even, the earliest of
+// the two middle items is returned. The list is not modified. It panics if the
+// list is empty.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr|) on average.
+func Median[T any](arr []T, comp utils.Comparator[T]) T {
+	if len(arr) == 0 {
+		panic("median of an empty list")
+	}
+	cpy := make([]T, len(arr))
+	copy(cpy, arr)
+
+	mid := (len(cpy) - 1) / 2
+	NthElement(cpy, mid, comp)
+	return cpy[mid]
+}
+
+// insertionSortThreshold is the length under which insertion sort is faster
+// than partitioning.
+const insertionSortThreshold = 16
+
+// insertionSort sorts a short list according to comp.
+//
+// Complexity is O(|arr|²).
+func insertionSort[T any](arr []T, comp utils.Comparator[T]) {
+	for i := 1; i < len(arr); i++ {
+		for j := i; j > 0 && comp(arr[j], arr[j-1]); j-- {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+	}
+}
+
+// medianOfThree returns the median of the first, middle and last items
+// of a non-empty list.
+func medianOfThree[T any](arr []T, comp utils.Comparator[T]) T {
+	a, b, c := arr[0], arr[len(arr)/2], arr[len(arr)-1]
+	if comp(b, a) {
+		a, b = b, a
+	}
+	if comp(c, b) {
+		b = c
+		if comp(b, a) {
+			b = a
+		}
+	}
+	return b
+}
+
+// partition3 rearranges a list into three groups: the items that preceed the
+// pivot in [0, lt), the items equivalent to it in [lt, gt), and the items that
+// succeed it in [gt, |arr|).
+//
+// Complexity is O(|arr|).
+func partition3[T any](arr []T, pivot T, comp utils.Comparator[T]) (lt, gt int) {
+	i := 0
+	gt = len(arr)
+	for i < gt {
+		switch {
+		case comp(arr[i], pivot):
+			arr[i], arr[lt] = arr[lt], arr[i]
+			lt++
+			i++
+		case comp(pivot, arr[i]):
+			gt--
+			arr[i], arr[gt] = arr[gt], arr[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// firstNWithHeap is the strategy of FirstN for medium values of n. It keeps
+// the best n items seen so far in a heap, with the worst of them on top.
+//
+// It needs O(n) additional memory.
+//
+// Complexity is O(|arr|·log(n)).
+func firstNWithHeap[T any](arr []T, n int, comp utils.Comparator[T]) []T {
+	acc := make([]T, n)
+	copy(acc, arr[:n])
+
+	worst := dstruct.HeapFromSlice(acc, func(a, b T) bool { return comp(b, a) })
+	for _, x := range arr[n:] {
+		top := &(*worst.Data())[0]
+		if comp(x, *top) {
+			*top = x
+			worst.Fix(0)
+		}
+	}
+
+	acc = *worst.Data()
+	Sort(acc, comp)
+	return acc
+}
+
+// firstNWithSelection is the strategy of FirstN for large values of n. It
+// selects the best n items in a copy of the list.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr| + n·log(n)) on average.
+func firstNWithSelection[T any](arr []T, n int, comp utils.Comparator[T]) []T {
+	cpy := make([]T, len(arr))
+	copy(cpy, arr)
+	PartialSort(cpy, n, comp)
+	return cpy[:n:n]
+}
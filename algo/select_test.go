@@ -0,0 +1,238 @@
+package algo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNthElement(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testNthElement[int])
+	t.Run("int8", testNthElement[int8])
+	t.Run("int32", testNthElement[int32])
+	t.Run("int64", testNthElement[int64])
+	t.Run("float32", testNthElement[float32])
+	t.Run("float64", testNthElement[float64])
+}
+
+func TestPartialSort(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testPartialSort[int])
+	t.Run("int8", testPartialSort[int8])
+	t.Run("int32", testPartialSort[int32])
+	t.Run("int64", testPartialSort[int64])
+	t.Run("float32", testPartialSort[float32])
+	t.Run("float64", testPartialSort[float64])
+}
+
+func TestMedian(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testMedian[int])
+	t.Run("int8", testMedian[int8])
+	t.Run("int32", testMedian[int32])
+	t.Run("int64", testMedian[int64])
+	t.Run("float32", testMedian[float32])
+	t.Run("float64", testMedian[float64])
+}
+
+func TestFirstNStrategies(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	input := algo.Generate(1000, func() int { return rng.Intn(500) })
+
+	want := append([]int{}, input...)
+	algo.Sort(want, utils.Gt[int])
+
+	// Covers insertion, heap and selection.
+	for _, n := range []uint{0, 1, 5, 16, 17, 50, 125, 126, 500, 999, 1000, 2000} {
+		n := n
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			t.Parallel()
+
+			cpy := append([]int{}, input...)
+			got := algo.FirstN(cpy, n, utils.Gt[int])
+			require.Equal(t, want[:utils.Min(int(n), len(want))], got)
+			require.Equal(t, input, cpy, "Input must not be modified")
+		})
+	}
+}
+
+func testNthElement[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []T
+		comp  utils.Comparator[T]
+	}{
+		"single":        {input: []T{1}, comp: utils.Lt[T]},
+		"small":         {input: []T{3, 1, 2}, comp: utils.Lt[T]},
+		"small reverse": {input: []T{3, 1, 2}, comp: utils.Gt[T]},
+		"sorted":        {input: algo.Generate(100, counter[T](0, 1)), comp: utils.Lt[T]},
+		"reversed":      {input: algo.Generate(100, counter[T](100, -1)), comp: utils.Lt[T]},
+		"all equal":     {input: algo.Generate(100, func() T { return 7 }), comp: utils.Lt[T]},
+		"two values":    {input: algo.Generate(100, alternating[T](3, 9)), comp: utils.Gt[T]},
+		"organ pipe":    {input: append(algo.Generate(50, counter[T](0, 1)), algo.Generate(50, counter[T](50, -1))...), comp: utils.Lt[T]},
+		"random":        {input: randomSorted[T](rand.New(rand.NewSource(1)), 120, 100), comp: utils.Gt[T]}, //nolint: gosec // Deterministic tests.
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := append([]T{}, tc.input...)
+			algo.Sort(want, tc.comp)
+
+			for n := range tc.input {
+				got := append([]T{}, tc.input...)
+				algo.NthElement(got, n, tc.comp)
+				require.Equal(t, want[n], got[n], "Wrong element at position %d", n)
+				for i := range got[:n] {
+					require.False(t, tc.comp(got[n], got[i]), "Item %d succeeds item %d", i, n)
+				}
+				for i := range got[n+1:] {
+					require.False(t, tc.comp(got[n+1+i], got[n]), "Item %d preceeds item %d", n+1+i, n)
+				}
+				require.ElementsMatch(t, tc.input, got, "Items must be preserved")
+			}
+		})
+	}
+
+	t.Run("random shuffled", func(t *testing.T) {
+		t.Parallel()
+
+		rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+		for i := 0; i < 50; i++ {
+			input := algo.Generate(1+rng.Intn(2000), func() T { return T(rng.Intn(100)) })
+			want := append([]T{}, input...)
+			algo.Sort(want, utils.Lt[T])
+
+			n := rng.Intn(len(input))
+			algo.NthElement(input, n, utils.Lt[T])
+			require.Equal(t, want[n], input[n])
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		t.Parallel()
+
+		require.Panics(t, func() { algo.NthElement([]T{}, 0, utils.Lt[T]) })
+		require.Panics(t, func() { algo.NthElement([]T{1, 2}, 2, utils.Lt[T]) })
+		require.Panics(t, func() { algo.NthElement([]T{1, 2}, -1, utils.Lt[T]) })
+	})
+}
+
+func testPartialSort[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []T
+		k     int
+		comp  utils.Comparator[T]
+		want  []T
+	}{
+		"empty":        {input: []T{}, k: 0, comp: utils.Lt[T], want: []T{}},
+		"zero":         {input: []T{3, 1, 2}, k: 0, comp: utils.Lt[T], want: []T{}},
+		"one":          {input: []T{3, 1, 2}, k: 1, comp: utils.Lt[T], want: []T{1}},
+		"all":          {input: []T{3, 1, 2}, k: 3, comp: utils.Lt[T], want: []T{1, 2, 3}},
+		"too many":     {input: []T{3, 1, 2}, k: 5, comp: utils.Lt[T], want: []T{1, 2, 3}},
+		"bottom 3":     {input: []T{8, 7, 5, 3, 3, 15}, k: 3, comp: utils.Lt[T], want: []T{3, 3, 5}},
+		"top 3":        {input: []T{8, 7, 5, 3, 3, 15}, k: 3, comp: utils.Gt[T], want: []T{15, 8, 7}},
+		"long top 5":   {input: algo.Generate(100, counter[T](0, 1)), k: 5, comp: utils.Gt[T], want: []T{99, 98, 97, 96, 95}},
+		"long bottom5": {input: algo.Generate(100, counter[T](100, -1)), k: 5, comp: utils.Lt[T], want: []T{1, 2, 3, 4, 5}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := append([]T{}, tc.input...)
+			algo.PartialSort(got, tc.k, tc.comp)
+			require.Equal(t, tc.want, got[:len(tc.want)])
+			require.ElementsMatch(t, tc.input, got, "Items must be preserved")
+		})
+	}
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+		require.Panics(t, func() { algo.PartialSort([]T{1, 2}, -1, utils.Lt[T]) })
+	})
+}
+
+func testMedian[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []T
+		comp  utils.Comparator[T]
+		want  T
+	}{
+		"single":       {input: []T{4}, comp: utils.Lt[T], want: 4},
+		"odd":          {input: []T{5, 1, 3}, comp: utils.Lt[T], want: 3},
+		"even":         {input: []T{5, 1, 3, 8}, comp: utils.Lt[T], want: 3},
+		"even greater": {input: []T{5, 1, 3, 8}, comp: utils.Gt[T], want: 5},
+		"repeated":     {input: []T{2, 2, 9, 2, 1}, comp: utils.Lt[T], want: 2},
+		"long":         {input: algo.Generate(101, counter[T](100, -1)), comp: utils.Lt[T], want: 50},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cpy := append([]T{}, tc.input...)
+			require.Equal(t, tc.want, algo.Median(cpy, tc.comp))
+			require.Equal(t, tc.input, cpy, "Input must not be modified")
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		require.Panics(t, func() { algo.Median([]T{}, utils.Lt[T]) })
+	})
+}
+
+func BenchmarkFirstN(b *testing.B) {
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	input := algo.Generate(1_000_000, rng.Int)
+
+	for _, n := range []uint{10, 1_000, 100_000} {
+		n := n
+		b.Run(fmt.Sprintf("FirstN/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				algo.FirstN(input, n, utils.Lt[int])
+			}
+		})
+		b.Run(fmt.Sprintf("Sort/%d", n), func(b *testing.B) {
+			arr := make([]int, len(input))
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				algo.Sort(arr, utils.Lt[int])
+			}
+		})
+	}
+}
+
+// counter returns a generator of the sequence start, start+step, start+2·step, ...
+func counter[T utils.Signed](start, step T) func() T {
+	next := start
+	return func() T {
+		defer func() { next += step }()
+		return next
+	}
+}
+
+// alternating returns a generator of the sequence a, b, a, b, ...
+func alternating[T any](a, b T) func() T {
+	return func() T {
+		a, b = b, a
+		return b
+	}
+}
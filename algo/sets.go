@@ -0,0 +1,252 @@
+package algo
+
+import (
+	"github.com/EduardGomezEscandell/algo/dstruct"
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Union finds all elements that are in either of two slices.
+//
+// The lists are expected to have been sorted with the comparator 'comp'.
+// Two items a,b are considered equivalent if both comp(a,b) and comp(b,a)
+// are false.
+//
+// It returns a sorted slice with their items. Items in the output list are
+// repeated as many times as the largest number of repetitions between the
+// two lists. Equivalent items are taken from the first list when possible.
+//
+// Complexity is O(|first| + |second|).
+func Union[T any](first, second []T, comp utils.Comparator[T]) []T {
+	out := make([]T, 0, utils.Max(len(first), len(second)))
+	var f, s int
+	for f < len(first) && s < len(second) {
+		switch {
+		case comp(first[f], second[s]):
+			out = append(out, first[f])
+			f++
+		case comp(second[s], first[f]):
+			out = append(out, second[s])
+			s++
+		default:
+			out = append(out, first[f])
+			f++
+			s++
+		}
+	}
+	out = append(out, first[f:]...)
+	return append(out, second[s:]...)
+}
+
+// Difference finds all elements in the first slice that are not in the
+// second one.
+//
+// The lists are expected to have been sorted with the comparator 'comp'.
+// Two items a,b are considered equivalent if both comp(a,b) and comp(b,a)
+// are false.
+//
+// It returns a sorted slice with the remaining items. Items in the output
+// list are repeated as many times as they are in the first list, minus the
+// times they are in the second one.
+//
+// Complexity is O(|first| + |second|).
+func Difference[T any](first, second []T, comp utils.Comparator[T]) []T {
+	out := []T{}
+	var f, s int
+	for f < len(first) && s < len(second) {
+		switch {
+		case comp(first[f], second[s]):
+			out = append(out, first[f])
+			f++
+		case comp(second[s], first[f]):
+			s++
+		default:
+			f++
+			s++
+		}
+	}
+	return append(out, first[f:]...)
+}
+
+// SymmetricDifference finds all elements that are in only one of two slices.
+//
+// The lists are expected to have been sorted with the comparator 'comp'.
+// Two items a,b are considered equivalent if both comp(a,b) and comp(b,a)
+// are false.
+//
+// It returns a sorted slice with the remaining items. Items in the output
+// list are repeated as many times as the difference between their number
+// of repetitions in either list.
+//
+// Complexity is O(|first| + |second|).
+func SymmetricDifference[T any](first, second []T, comp utils.Comparator[T]) []T {
+	out := []T{}
+	var f, s int
+	for f < len(first) && s < len(second) {
+		switch {
+		case comp(first[f], second[s]):
+			out = append(out, first[f])
+			f++
+		case comp(second[s], first[f]):
+			out = append(out, second[s])
+			s++
+		default:
+			f++
+			s++
+		}
+	}
+	out = append(out, first[f:]...)
+	return append(out, second[s:]...)
+}
+
+// Merge combines two sorted slices into a single sorted slice with all
+// their elements.
+//
+// The lists are expected to have been sorted with the comparator 'comp'.
+// Two items a,b are considered equivalent if both comp(a,b) and comp(b,a)
+// are false.
+//
+// The merge is stable: equivalent items keep their relative order, and
+// those from the first list preceed those from the second one.
+//
+// Complexity is O(|first| + |second|).
+func Merge[T any](first, second []T, comp utils.Comparator[T]) []T {
+	out := make([]T, len(first)+len(second))
+	inplace.Merge(out, first, second, comp)
+	return out
+}
+
+// Includes returns true if every element in the second slice is also in the
+// first one. Items must be repeated in the first list at least as many times
+// as in the second one.
+//
+// The lists are expected to have been sorted with the comparator 'comp'.
+// Two items a,b are considered equivalent if both comp(a,b) and comp(b,a)
+// are false.
+//
+// Complexity is O(|first| + |second|).
+func Includes[T any](first, second []T, comp utils.Comparator[T]) bool {
+	var f, s int
+	for s < len(second) {
+		switch {
+		case f == len(first), comp(second[s], first[f]):
+			return false
+		case comp(first[f], second[s]):
+			f++
+		default:
+			f++
+			s++
+		}
+	}
+	return true
+}
+
+// MergeAll is the same as Merge, but it combines any number of lists. Out of
+// equivalent items, those in earlier lists preceed those in later lists.
+//
+// Complexity is O(n·log(k)), where n is the total number of items and k is
+// the number of lists.
+func MergeAll[T any](lists [][]T, comp utils.Comparator[T]) []T {
+	out := make([]T, 0, MapReduce(lists, func(l []T) int { return len(l) }, utils.Add[int], 0))
+	equivalentRuns(lists, comp, func(runs [][]T) {
+		for _, r := range runs {
+			out = append(out, r...)
+		}
+	})
+	return out
+}
+
+// UnionAll is the same as Union, but it combines any number of lists. Items in
+// the output list are repeated as many times as the largest number of
+// repetitions among all lists. Equivalent items are taken from the earliest
+// list with that many repetitions.
+//
+// Complexity is O(n·log(k)), where n is the total number of items and k is
+// the number of lists.
+func UnionAll[T any](lists [][]T, comp utils.Comparator[T]) []T {
+	out := []T{}
+	equivalentRuns(lists, comp, func(runs [][]T) {
+		longest := runs[0]
+		for _, r := range runs[1:] {
+			if len(r) > len(longest) {
+				longest = r
+			}
+		}
+		out = append(out, longest...)
+	})
+	return out
+}
+
+// IntersectAll is the same as Intersect, but it combines any number of lists.
+// Items in the output list are repeated as many times as the smallest number
+// of repetitions among all lists. Equivalent items are taken from the first
+// list.
+//
+// Complexity is O(n·log(k)), where n is the total number of items and k is
+// the number of lists.
+func IntersectAll[T any](lists [][]T, comp utils.Comparator[T]) []T {
+	out := []T{}
+	if len(lists) == 0 {
+		return out
+	}
+	equivalentRuns(lists, comp, func(runs [][]T) {
+		if len(runs) < len(lists) {
+			return // Missing from some list.
+		}
+		shortest := len(runs[0])
+		for _, r := range runs[1:] {
+			shortest = utils.Min(shortest, len(r))
+		}
+		out = append(out, runs[0][:shortest]...)
+	})
+	return out
+}
+
+// equivalentRuns traverses any number of sorted lists in order, calling f once
+// per set of equivalent items. The argument runs contains the items of every
+// list that has any in the set, in the order of the lists. Lists without any
+// are left out, so that the cost does not depend on the number of lists.
+func equivalentRuns[T any](lists [][]T, comp utils.Comparator[T], f func(runs [][]T)) {
+	head := func(c cursor) T { return lists[c.list][c.pos] }
+	queue := dstruct.NewHeap(func(a, b cursor) bool {
+		// Ties are broken by list, so equivalent items come out in list order.
+		x, y := head(a), head(b)
+		return comp(x, y) || (!comp(y, x) && a.list < b.list)
+	})
+	for i, l := range lists {
+		if len(l) != 0 {
+			queue.Push(cursor{list: i})
+		}
+	}
+
+	var runs [][]T
+	for queue.Len() > 0 {
+		runs = runs[:0]
+
+		rep := head((*queue.Data())[0])
+		for queue.Len() > 0 {
+			c := (*queue.Data())[0]
+			if comp(rep, head(c)) {
+				break // Next set of equivalent items.
+			}
+			queue.Pop()
+
+			l := lists[c.list]
+			end := c.pos + 1
+			for end < len(l) && !comp(rep, l[end]) {
+				end++
+			}
+			runs = append(runs, l[c.pos:end])
+			if end < len(l) {
+				queue.Push(cursor{list: c.list, pos: end})
+			}
+		}
+
+		f(runs)
+	}
+}
+
+// cursor points to the next unvisited item in one of the lists of a k-way operation.
+type cursor struct {
+	list, pos int
+}
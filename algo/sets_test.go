@@ -0,0 +1,236 @@
+package algo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testSetOperations[int])
+	t.Run("int8", testSetOperations[int8])
+	t.Run("int32", testSetOperations[int32])
+	t.Run("int64", testSetOperations[int64])
+}
+
+func TestKWaySetOperations(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testKWaySetOperations[int])
+	t.Run("int8", testKWaySetOperations[int8])
+	t.Run("int32", testKWaySetOperations[int32])
+	t.Run("int64", testKWaySetOperations[int64])
+}
+
+func TestMergeIsStable(t *testing.T) {
+	t.Parallel()
+
+	type item struct{ key, origin int }
+	comp := func(a, b item) bool { return a.key < b.key }
+
+	first := []item{{1, 0}, {2, 0}, {2, 1}, {5, 0}}
+	second := []item{{2, 2}, {3, 0}, {5, 1}}
+	third := []item{{1, 1}, {2, 3}}
+
+	want := []item{{1, 0}, {2, 0}, {2, 1}, {2, 2}, {3, 0}, {5, 0}, {5, 1}}
+	require.Equal(t, want, algo.Merge(first, second, comp))
+
+	want = []item{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {2, 2}, {2, 3}, {3, 0}, {5, 0}, {5, 1}}
+	require.Equal(t, want, algo.MergeAll([][]item{first, second, third}, comp))
+
+	// Equivalent items are taken from the first list.
+	require.Equal(t, []item{{1, 0}, {2, 0}, {2, 1}, {3, 0}, {5, 0}}, algo.Union(first, second, comp))
+	require.Equal(t, []item{{1, 0}, {2, 0}, {2, 1}, {3, 0}, {5, 0}}, algo.UnionAll([][]item{first, second, third}, comp))
+	require.Equal(t, []item{{2, 0}}, algo.IntersectAll([][]item{first, second, third}, comp))
+}
+
+func testSetOperations[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		first, second []T
+		comp          utils.Comparator[T]
+
+		union, difference, symmetric, merge []T
+		includes                            bool
+	}{
+		"empty": {comp: utils.Lt[T], union: []T{}, difference: []T{}, symmetric: []T{}, merge: []T{}, includes: true},
+		"first empty": {
+			comp: utils.Lt[T], second: []T{1, 2},
+			union: []T{1, 2}, difference: []T{}, symmetric: []T{1, 2}, merge: []T{1, 2}, includes: false,
+		},
+		"second empty": {
+			comp: utils.Lt[T], first: []T{1, 2},
+			union: []T{1, 2}, difference: []T{1, 2}, symmetric: []T{1, 2}, merge: []T{1, 2}, includes: true,
+		},
+		"disjoint": {
+			comp: utils.Lt[T], first: []T{1, 3, 5}, second: []T{2, 4},
+			union: []T{1, 2, 3, 4, 5}, difference: []T{1, 3, 5}, symmetric: []T{1, 2, 3, 4, 5}, merge: []T{1, 2, 3, 4, 5}, includes: false,
+		},
+		"subset": {
+			comp: utils.Lt[T], first: []T{1, 2, 3, 4}, second: []T{2, 4},
+			union: []T{1, 2, 3, 4}, difference: []T{1, 3}, symmetric: []T{1, 3}, merge: []T{1, 2, 2, 3, 4, 4}, includes: true,
+		},
+		"repeats": {
+			comp: utils.Lt[T], first: []T{1, 1, 1, 2, 3}, second: []T{1, 2, 2, 4},
+			union: []T{1, 1, 1, 2, 2, 3, 4}, difference: []T{1, 1, 3}, symmetric: []T{1, 1, 2, 3, 4},
+			merge: []T{1, 1, 1, 1, 2, 2, 2, 3, 4}, includes: false,
+		},
+		"not enough repeats": {
+			comp: utils.Lt[T], first: []T{1, 2, 3}, second: []T{2, 2},
+			union: []T{1, 2, 2, 3}, difference: []T{1, 3}, symmetric: []T{1, 2, 3}, merge: []T{1, 2, 2, 2, 3}, includes: false,
+		},
+		"greater than": {
+			comp: utils.Gt[T], first: []T{9, 5, 5, 1}, second: []T{7, 5, 1, 0},
+			union: []T{9, 7, 5, 5, 1, 0}, difference: []T{9, 5}, symmetric: []T{9, 7, 5, 0}, merge: []T{9, 7, 5, 5, 5, 1, 1, 0}, includes: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.union, algo.Union(tc.first, tc.second, tc.comp), "Wrong union")
+			require.Equal(t, tc.difference, algo.Difference(tc.first, tc.second, tc.comp), "Wrong difference")
+			require.Equal(t, tc.symmetric, algo.SymmetricDifference(tc.first, tc.second, tc.comp), "Wrong symmetric difference")
+			require.Equal(t, tc.merge, algo.Merge(tc.first, tc.second, tc.comp), "Wrong merge")
+			require.Equal(t, tc.includes, algo.Includes(tc.first, tc.second, tc.comp), "Wrong inclusion")
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+
+		rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+		for i := 0; i < 200; i++ {
+			first := randomSorted[T](rng, rng.Intn(20), 10)
+			second := randomSorted[T](rng, rng.Intn(20), 10)
+			cf, cs := counts(first), counts(second)
+
+			msg := fmt.Sprintf("first: %v, second: %v", first, second)
+			require.Equal(t, fromCounts(combine(cf, cs, utils.Max[int])), algo.Union(first, second, utils.Lt[T]), msg)
+			require.Equal(t, fromCounts(combine(cf, cs, utils.Min[int])), algo.Intersect(first, second, utils.Lt[T]), msg)
+			require.Equal(t, fromCounts(combine(cf, cs, func(a, b int) int { return utils.Max(a-b, 0) })), algo.Difference(first, second, utils.Lt[T]), msg)
+			require.Equal(t, fromCounts(combine(cf, cs, func(a, b int) int { return algo.Abs(a - b) })), algo.SymmetricDifference(first, second, utils.Lt[T]), msg)
+			require.Equal(t, fromCounts(combine(cf, cs, utils.Add[int])), algo.Merge(first, second, utils.Lt[T]), msg)
+
+			includes := true
+			for k, c := range cs {
+				includes = includes && cf[k] >= c
+			}
+			require.Equal(t, includes, algo.Includes(first, second, utils.Lt[T]), msg)
+		}
+	})
+}
+
+func testKWaySetOperations[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	for i := 0; i < 100; i++ {
+		lists := make([][]T, rng.Intn(8))
+		for j := range lists {
+			lists[j] = randomSorted[T](rng, rng.Intn(15), 10)
+		}
+
+		t.Run(fmt.Sprint(lists), func(t *testing.T) {
+			t.Parallel()
+
+			wantMerge, wantUnion, wantIntersect := []T{}, []T{}, []T{}
+			if len(lists) > 0 {
+				wantMerge, wantUnion, wantIntersect = lists[0], lists[0], lists[0]
+			}
+			for _, l := range lists[utils.Min(1, len(lists)):] {
+				wantMerge = algo.Merge(wantMerge, l, utils.Lt[T])
+				wantUnion = algo.Union(wantUnion, l, utils.Lt[T])
+				wantIntersect = algo.Intersect(wantIntersect, l, utils.Lt[T])
+			}
+
+			require.Equal(t, wantMerge, algo.MergeAll(lists, utils.Lt[T]), "Wrong merge")
+			require.Equal(t, wantUnion, algo.UnionAll(lists, utils.Lt[T]), "Wrong union")
+			require.Equal(t, wantIntersect, algo.IntersectAll(lists, utils.Lt[T]), "Wrong intersection")
+		})
+	}
+}
+
+func TestKWaySetOperationsManyLists(t *testing.T) {
+	t.Parallel()
+
+	// Most lists have no item in most sets of equivalent items.
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	lists := make([][]int, 1000)
+	for i := range lists {
+		lists[i] = randomSorted[int](rng, rng.Intn(5), 1000)
+	}
+	lists = append(lists, algo.Generate(1000, counter(0, 1)))
+
+	wantMerge, wantUnion := lists[0], lists[0]
+	for _, l := range lists[1:] {
+		wantMerge = algo.Merge(wantMerge, l, utils.Lt[int])
+		wantUnion = algo.Union(wantUnion, l, utils.Lt[int])
+	}
+
+	require.Equal(t, wantMerge, algo.MergeAll(lists, utils.Lt[int]), "Wrong merge")
+	require.Equal(t, wantUnion, algo.UnionAll(lists, utils.Lt[int]), "Wrong union")
+	require.Empty(t, algo.IntersectAll(lists, utils.Lt[int]), "Wrong intersection")
+}
+
+func BenchmarkMergeAll(b *testing.B) {
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+
+	const size = 400_000
+	for _, k := range []int{10, 1_000, 4_000} {
+		lists := make([][]int, k)
+		for i := range lists {
+			lists[i] = randomSorted[int](rng, size/k, size)
+		}
+
+		b.Run(fmt.Sprint(k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				algo.MergeAll(lists, utils.Lt[int])
+			}
+		})
+	}
+}
+
+// randomSorted generates a sorted array with values in the range [0, maxValue).
+func randomSorted[T utils.Number](rng *rand.Rand, size, maxValue int) []T {
+	arr := algo.Generate(size, func() T { return T(rng.Intn(maxValue)) })
+	algo.Sort(arr, utils.Lt[T])
+	return arr
+}
+
+func counts[T comparable](arr []T) map[T]int {
+	c := map[T]int{}
+	for _, v := range arr {
+		c[v]++
+	}
+	return c
+}
+
+func combine[T comparable](a, b map[T]int, f func(int, int) int) map[T]int {
+	out := map[T]int{}
+	for k := range a {
+		out[k] = f(a[k], b[k])
+	}
+	for k := range b {
+		out[k] = f(a[k], b[k])
+	}
+	return out
+}
+
+// fromCounts builds a sorted array with every key repeated as many times as its count.
+func fromCounts[T utils.Number](c map[T]int) []T {
+	out := []T{}
+	for k, n := range c {
+		for i := 0; i < n; i++ {
+			out = append(out, k)
+		}
+	}
+	algo.Sort(out, utils.Lt[T])
+	return out
+}
@@ -0,0 +1,243 @@
+package algo
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// StableSort sorts a list according to a comparator comp, like Sort. Unlike
+// Sort, equivalent items keep their original relative order. Hence, a list can
+// be sorted by several criteria by sorting it once per criterion, from the
+// least important to the most important one.
+//
+// Example: sort by age, and people with the same age by name:
+//
+//	StableSort(people, func(a, b Person) bool { return a.Name < b.Name })
+//	StableSort(people, func(a, b Person) bool { return a.Age < b.Age })
+//
+// Complexity is O(|arr|·log²(|arr|)).
+func StableSort[T any](arr []T, comp utils.Comparator[T]) {
+	sort.SliceStable(arr, func(i, j int) bool {
+		return comp(arr[i], arr[j])
+	})
+}
+
+// SortBy sorts a list in increasing order of key(item). The key of every item
+// is computed only once, so it is faster than Sort when the key is expensive
+// to compute. The sort is stable.
+//
+// Example: sort strings by their lowercase form:
+//
+//	SortBy(arr, strings.ToLower)
+//
+// Complexity is O(|arr|·log²(|arr|)), with |arr| calls to key.
+func SortBy[T any, K constraints.Ordered](arr []T, key func(T) K) {
+	sort.Stable(keyed[T, K]{items: arr, keys: Map(arr, key)})
+}
+
+// keyed sorts a list of items alongside their cached keys.
+type keyed[T any, K constraints.Ordered] struct {
+	items []T
+	keys  []K
+}
+
+func (k keyed[T, K]) Len() int           { return len(k.items) }
+func (k keyed[T, K]) Less(i, j int) bool { return k.keys[i] < k.keys[j] }
+func (k keyed[T, K]) Swap(i, j int) {
+	k.items[i], k.items[j] = k.items[j], k.items[i]
+	k.keys[i], k.keys[j] = k.keys[j], k.keys[i]
+}
+
+// RadixSort sorts a list of integers in increasing order, with a least
+// significant digit radix sort. It does no comparisons, so it beats Sort
+// on large lists.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(w·|arr|), where w is the size of T in bytes.
+func RadixSort[T constraints.Integer](arr []T) {
+	RadixSortBy(arr, func(t T) T { return t })
+}
+
+// RadixSortBy sorts a list in increasing order of key(item), with a least
+// significant digit radix sort. The key of every item is computed only once.
+// The sort is stable.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(w·|arr|), where w is the size of K in bytes.
+func RadixSortBy[T any, K constraints.Integer](arr []T, key func(T) K) {
+	bits := bitSize[K]()
+	signed := ^K(0) < 0
+
+	// Keys are mapped to unsigned integers with the same ordering.
+	keys := Map(arr, func(t T) uint64 {
+		k := uint64(key(t))
+		if signed {
+			k ^= 1 << (bits - 1)
+		}
+		if bits < 64 {
+			k &= 1<<bits - 1
+		}
+		return k
+	})
+
+	radixSort(arr, keys, int(bits/8), 256, func(k uint64, pass int) int {
+		return int(k >> (8 * pass) & 0xff)
+	})
+}
+
+// bitSize returns the number of bits in an integer type.
+func bitSize[K constraints.Integer]() uint {
+	bits := uint(1)
+	for k := K(1); k<<1 != 0; k <<= 1 {
+		bits++
+	}
+	return bits
+}
+
+// RadixSortByString sorts a list in lexicographical order of key(item), with a
+// least significant digit radix sort. The key of every item is computed only
+// once. The sort is stable.
+//
+// Every item is visited once per byte in the longest key, so it is best suited
+// for keys of similar length, such as codes or fixed-width identifiers.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(w·|arr|), where w is the length of the longest key.
+func RadixSortByString[T any](arr []T, key func(T) string) {
+	keys := Map(arr, key)
+	width := MapReduce(keys, func(k string) int { return len(k) }, utils.Max[int], 0)
+
+	// Keys shorter than the current position go to bucket zero, so they
+	// preceed any key that continues.
+	radixSort(arr, keys, width, 257, func(k string, pass int) int {
+		pos := width - pass - 1
+		if pos >= len(k) {
+			return 0
+		}
+		return int(k[pos]) + 1
+	})
+}
+
+// radixSort sorts items alongside their keys with a least significant digit
+// radix sort. The number of passes is 'width', and digit(k, pass) must return
+// a value in the range [0, base), with pass zero being the least significant
+// digit. Passes where all keys share the same digit are skipped.
+func radixSort[T, K any](arr []T, keys []K, width, base int, digit func(K, int) int) {
+	bufItems := make([]T, len(arr))
+	bufKeys := make([]K, len(keys))
+	count := make([]int, base)
+
+	items := arr
+	for pass := 0; pass < width; pass++ {
+		for i := range count {
+			count[i] = 0
+		}
+		for _, k := range keys {
+			count[digit(k, pass)]++
+		}
+		if len(keys) == 0 || count[digit(keys[0], pass)] == len(keys) {
+			continue
+		}
+
+		start := 0
+		for i, c := range count {
+			count[i] = start
+			start += c
+		}
+		for i, k := range keys {
+			d := digit(k, pass)
+			bufItems[count[d]] = items[i]
+			bufKeys[count[d]] = k
+			count[d]++
+		}
+
+		items, bufItems = bufItems, items
+		keys, bufKeys = bufKeys, keys
+	}
+
+	copy(arr, items)
+}
+
+// countingSortMinSpan is the range of values that CountingSort always
+// accepts, no matter how short the list is.
+const countingSortMinSpan = 1 << 16
+
+// CountingSort sorts a list of integers in increasing order, by counting how
+// many times each value appears. It does no comparisons, so it beats Sort and
+// RadixSort when the range of values is small compared to the length of the
+// list.
+//
+// If the range of values is much larger than the list, counting would take
+// longer than a radix sort, so it falls back to RadixSort instead.
+//
+// It needs O(min(max-min, |arr|)) additional memory, where max and min are the
+// largest and smallest values in the list.
+//
+// Complexity is O(|arr| + min(max-min, |arr|)).
+func CountingSort[T constraints.Integer](arr []T) {
+	if len(arr) == 0 {
+		return
+	}
+	lo, hi := arr[0], arr[0]
+	for _, v := range arr[1:] {
+		lo = utils.Min(lo, v)
+		hi = utils.Max(hi, v)
+	}
+
+	// The span is computed before adding one, so that it cannot wrap around
+	// for the whole range of a 64-bit type. RadixSort makes up to 8 passes
+	// over the list, so it is faster for larger spans.
+	span := uint64(hi) - uint64(lo)
+	if span >= uint64(utils.Max(countingSortMinSpan, 8*len(arr))) {
+		RadixSort(arr)
+		return
+	}
+
+	count := make([]int, span+1)
+	for _, v := range arr {
+		count[uint64(v)-uint64(lo)]++
+	}
+
+	i := 0
+	for k, c := range count {
+		for ; c > 0; c-- {
+			arr[i] = lo + T(k)
+			i++
+		}
+	}
+}
+
+// CountingSortBy sorts a list in increasing order of key(item), which must
+// be in the range [0, size). The key of every item is computed only once.
+// The sort is stable.
+//
+// It needs O(|arr| + size) additional memory.
+//
+// Complexity is O(|arr| + size).
+func CountingSortBy[T any](arr []T, key func(T) int, size int) {
+	keys := Map(arr, key)
+	count := make([]int, size+1)
+	for _, k := range keys {
+		if k < 0 || k >= size {
+			panic(fmt.Errorf("key %d out of range [0, %d)", k, size))
+		}
+		count[k+1]++
+	}
+	for i := 1; i < len(count); i++ {
+		count[i] += count[i-1]
+	}
+
+	sorted := make([]T, len(arr))
+	for i, k := range keys {
+		sorted[count[k]] = arr[i]
+		count[k]++
+	}
+	copy(arr, sorted)
+}
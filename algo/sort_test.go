@@ -0,0 +1,287 @@
+package algo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestIntegerSorts(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testIntegerSorts[int])
+	t.Run("int8", testIntegerSorts[int8])
+	t.Run("int32", testIntegerSorts[int32])
+	t.Run("int64", testIntegerSorts[int64])
+	t.Run("uint", testIntegerSorts[uint])
+	t.Run("uint8", testIntegerSorts[uint8])
+	t.Run("uint16", testIntegerSorts[uint16])
+	t.Run("uint64", testIntegerSorts[uint64])
+}
+
+func TestStableSorts(t *testing.T) {
+	t.Parallel()
+
+	type person struct {
+		name string
+		age  int
+	}
+
+	people := []person{
+		{"Mary", 35}, {"John", 20}, {"Anne", 35}, {"Ben", 7},
+		{"Anne", 20}, {"Zoe", 7}, {"Carl", 35}, {"Mary", 20},
+	}
+	want := []person{
+		{"Ben", 7}, {"Zoe", 7}, {"Anne", 20}, {"John", 20},
+		{"Mary", 20}, {"Anne", 35}, {"Carl", 35}, {"Mary", 35},
+	}
+
+	testCases := map[string]struct {
+		sortByName func([]person)
+		sortByAge  func([]person)
+	}{
+		"StableSort": {
+			sortByName: func(p []person) { algo.StableSort(p, func(a, b person) bool { return a.name < b.name }) },
+			sortByAge:  func(p []person) { algo.StableSort(p, func(a, b person) bool { return a.age < b.age }) },
+		},
+		"SortBy": {
+			sortByName: func(p []person) { algo.SortBy(p, func(a person) string { return a.name }) },
+			sortByAge:  func(p []person) { algo.SortBy(p, func(a person) int { return a.age }) },
+		},
+		"RadixSortBy": {
+			sortByName: func(p []person) { algo.RadixSortByString(p, func(a person) string { return a.name }) },
+			sortByAge:  func(p []person) { algo.RadixSortBy(p, func(a person) int { return a.age }) },
+		},
+		"CountingSortBy": {
+			sortByName: func(p []person) { algo.RadixSortByString(p, func(a person) string { return a.name }) },
+			sortByAge:  func(p []person) { algo.CountingSortBy(p, func(a person) int { return a.age }, 100) },
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := append([]person{}, people...)
+			tc.sortByName(got)
+			tc.sortByAge(got)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestRadixSortByString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []string
+	}{
+		"empty":          {input: []string{}},
+		"single":         {input: []string{"hello"}},
+		"empty strings":  {input: []string{"", "b", "", "a"}},
+		"prefixes":       {input: []string{"abc", "ab", "abcd", "a", "", "abd", "b"}},
+		"same length":    {input: []string{"XK-31", "AB-02", "XK-30", "ZZ-99", "AB-01"}},
+		"non-ascii":      {input: []string{"ñandú", "nube", "órbita", "zorro", "oso"}},
+		"repeated words": {input: strings.Fields("the quick brown fox jumps over the lazy dog and the fox")},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := append([]string{}, tc.input...)
+			algo.Sort(want, func(a, b string) bool { return a < b })
+
+			got := append([]string{}, tc.input...)
+			algo.RadixSortByString(got, func(s string) string { return s })
+			require.Equal(t, want, got)
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+
+		rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+		input := algo.Generate(1000, func() string { return randomString(rng, 6) })
+
+		want := append([]string{}, input...)
+		algo.Sort(want, func(a, b string) bool { return a < b })
+
+		algo.RadixSortByString(input, func(s string) string { return s })
+		require.Equal(t, want, input)
+	})
+}
+
+func TestCountingSortByPanics(t *testing.T) {
+	t.Parallel()
+
+	require.Panics(t, func() { algo.CountingSortBy([]int{1, 5, 2}, func(x int) int { return x }, 5) })
+	require.Panics(t, func() { algo.CountingSortBy([]int{1, -1, 2}, func(x int) int { return x }, 5) })
+}
+
+func testIntegerSorts[T constraints.Integer](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	// Extreme values of T.
+	var zero T
+	hi := ^zero
+	if hi < 0 {
+		hi = T(uint64(1)<<(bits[T]()-1) - 1)
+	}
+	lo := ^hi
+
+	testCases := map[string]struct {
+		input []T
+	}{
+		"empty":    {input: []T{}},
+		"single":   {input: []T{3}},
+		"sorted":   {input: []T{1, 2, 3, 4, 5}},
+		"reversed": {input: []T{5, 4, 3, 2, 1}},
+		"repeated": {input: []T{3, 1, 3, 3, 1, 0, 2}},
+		"extremes": {input: []T{0, hi, lo, 1, hi, lo + 1, hi - 1, lo}},
+		"wrapping": {input: []T{lo, hi}},
+	}
+
+	sorts := map[string]func([]T){
+		"StableSort":   func(arr []T) { algo.StableSort(arr, utils.Lt[T]) },
+		"SortBy":       func(arr []T) { algo.SortBy(arr, func(t T) T { return t }) },
+		"RadixSort":    algo.RadixSort[T],
+		"CountingSort": algo.CountingSort[T],
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := append([]T{}, tc.input...)
+			algo.Sort(want, utils.Lt[T])
+
+			for sortName, sort := range sorts {
+				got := append([]T{}, tc.input...)
+				sort(got)
+				require.Equal(t, want, got, "Wrong result for %s", sortName)
+			}
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+
+		rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+		input := algo.Generate(5000, func() T { return T(rng.Uint64()) })
+
+		want := append([]T{}, input...)
+		algo.Sort(want, utils.Lt[T])
+
+		got := append([]T{}, input...)
+		algo.RadixSort(got)
+		require.Equal(t, want, got, "Wrong result for RadixSort")
+
+		// Too wide a range to count, for types larger than 16 bits.
+		got = append([]T{}, input...)
+		algo.CountingSort(got)
+		require.Equal(t, want, got, "Wrong result for CountingSort with a wide range")
+
+		small := algo.Map(input, func(t T) T { return t % 100 })
+		want = append([]T{}, small...)
+		algo.Sort(want, utils.Lt[T])
+
+		got = append([]T{}, small...)
+		algo.CountingSort(got)
+		require.Equal(t, want, got, "Wrong result for CountingSort")
+
+		got = append([]T{}, small...)
+		algo.CountingSortBy(got, func(t T) int { return int(t) + 100 }, 200)
+		require.Equal(t, want, got, "Wrong result for CountingSortBy")
+	})
+}
+
+func BenchmarkSorts(b *testing.B) {
+	for _, size := range []int{100, 10_000, 1_000_000} {
+		// Values over the whole range of int.
+		b.Run(fmt.Sprintf("wide int/%d", size), func(b *testing.B) {
+			benchmarkSorts(b, size, 1<<62, map[string]func([]int){
+				"Sort":       func(arr []int) { algo.Sort(arr, utils.Lt[int]) },
+				"StableSort": func(arr []int) { algo.StableSort(arr, utils.Lt[int]) },
+				"RadixSort":  algo.RadixSort[int],
+			})
+		})
+
+		// Values in a small domain.
+		b.Run(fmt.Sprintf("narrow int/%d", size), func(b *testing.B) {
+			benchmarkSorts(b, size, 256, map[string]func([]int){
+				"Sort":         func(arr []int) { algo.Sort(arr, utils.Lt[int]) },
+				"RadixSort":    algo.RadixSort[int],
+				"CountingSort": algo.CountingSort[int],
+			})
+		})
+	}
+
+	// Keys that are expensive to compute.
+	for _, size := range []int{100, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("expensive key/%d", size), func(b *testing.B) {
+			key := func(s string) string { return strings.ToLower(s) }
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			input := algo.Generate(size, func() string { return strings.ToUpper(randomString(rng, 8)) })
+			arr := make([]string, size)
+
+			sorts := map[string]func([]string){
+				"StableSort":        func(arr []string) { algo.StableSort(arr, func(x, y string) bool { return key(x) < key(y) }) },
+				"SortBy":            func(arr []string) { algo.SortBy(arr, key) },
+				"RadixSortByString": func(arr []string) { algo.RadixSortByString(arr, key) },
+			}
+
+			for name, sort := range sorts {
+				b.Run(name, func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						copy(arr, input)
+						sort(arr)
+					}
+				})
+			}
+		})
+	}
+}
+
+// nolint: thelper // Not a helper, it runs the benchmarks.
+func benchmarkSorts(b *testing.B, size int, maxValue int64, sorts map[string]func([]int)) {
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	input := algo.Generate(size, func() int { return int(rng.Int63n(maxValue)) })
+	arr := make([]int, size)
+
+	for name, sort := range sorts {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				sort(arr)
+			}
+		})
+	}
+}
+
+// bits returns the number of bits in an integer type.
+func bits[T constraints.Integer]() uint {
+	n := uint(1)
+	for k := T(1); k<<1 != 0; k <<= 1 {
+		n++
+	}
+	return n
+}
+
+// randomString generates a string of lowercase letters with a length in the range [0, maxLen].
+func randomString(rng *rand.Rand, maxLen int) string {
+	b := make([]byte, rng.Intn(maxLen+1))
+	for i := range b {
+		b[i] = byte('a' + rng.Intn(26))
+	}
+	return string(b)
+}
@@ -0,0 +1,120 @@
+package algo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// SumMode is an algorithm to add up floating point numbers. They differ in how
+// their rounding error grows with the number of items n. Let ε be the machine
+// epsilon.
+type SumMode int
+
+const (
+	// Naive adds the items one after the other. It is the fastest, and its
+	// error grows with O(n·ε).
+	Naive SumMode = iota
+
+	// Pairwise splits the items in halves recursively, and adds up the sums
+	// of both halves. It is almost as fast as Naive, and its error grows with
+	// O(log(n)·ε).
+	Pairwise
+
+	// Neumaier keeps track of the rounding error of every addition, and adds
+	// it back at the end. It is the Kahan–Babuška algorithm, and it is the
+	// slowest of the three. Its error is O(ε), independent of n.
+	Neumaier
+)
+
+// pairwiseBlockSize is the number of items that pairwise summation adds
+// naively, to keep the overhead of the recursion low.
+const pairwiseBlockSize = 128
+
+// Sum adds up all the items in arr with the chosen algorithm.
+//
+// Complexity is O(|arr|).
+func Sum[T constraints.Float](arr []T, mode SumMode) T {
+	switch mode {
+	case Naive:
+		return Reduce(arr, utils.Add[T], 0)
+	case Pairwise:
+		return pairwiseSum(arr)
+	case Neumaier:
+		var acc NeumaierSum[T]
+		for _, x := range arr {
+			acc = acc.Add(x)
+		}
+		return acc.Value()
+	}
+	panic(fmt.Errorf("unknown sum mode: %d", mode))
+}
+
+// Dot computes the inner product of u and v, with the products and their
+// sums compensated for rounding error. Items past the length of the shortest
+// input are ignored.
+//
+// Complexity is O(min(|u|, |v|)).
+func Dot[T constraints.Float](u, v []T) T {
+	return ZipReduce(u, v, NewProduct[T], NeumaierSum[T].Merge, NeumaierSum[T]{}).Value()
+}
+
+func pairwiseSum[T constraints.Float](arr []T) T {
+	if len(arr) <= pairwiseBlockSize {
+		return Reduce(arr, utils.Add[T], 0)
+	}
+	mid := len(arr) / 2
+	return pairwiseSum(arr[:mid]) + pairwiseSum(arr[mid:])
+}
+
+// NeumaierSum is an accumulator for the Kahan–Babuška summation algorithm.
+// Alongside the sum, it stores the rounding error of all additions so far.
+// The zero value is an empty sum.
+//
+// The rounding errors are added up in float64 regardless of T. Otherwise, the
+// error of their own sum would dominate over long float32 sums.
+type NeumaierSum[T constraints.Float] struct {
+	sum          T
+	compensation float64
+}
+
+// NewProduct returns an accumulator containing the product x·y, with its
+// rounding error compensated.
+func NewProduct[T constraints.Float](x, y T) NeumaierSum[T] {
+	p := x * y
+	return NeumaierSum[T]{
+		sum:          p,
+		compensation: math.FMA(float64(x), float64(y), -float64(p)),
+	}
+}
+
+// Add returns the accumulator with x added to it.
+func (n NeumaierSum[T]) Add(x T) NeumaierSum[T] {
+	t := n.sum + x
+	if math.Abs(float64(n.sum)) >= math.Abs(float64(x)) {
+		n.compensation += float64((n.sum - t) + x)
+	} else {
+		n.compensation += float64((x - t) + n.sum)
+	}
+	n.sum = t
+	return n
+}
+
+// Merge returns the accumulator with the contents of another one added to it.
+func (n NeumaierSum[T]) Merge(other NeumaierSum[T]) NeumaierSum[T] {
+	n = n.Add(other.sum)
+	n.compensation += other.compensation
+	return n
+}
+
+// Value returns the compensated sum. If the sum is infinite or NaN, it is
+// returned as is, like with naive summation.
+func (n NeumaierSum[T]) Value() T {
+	// Adding an infinity makes the compensation NaN, so it must be left out.
+	if s := float64(n.sum); math.IsInf(s, 0) || math.IsNaN(s) {
+		return n.sum
+	}
+	return T(float64(n.sum) + n.compensation)
+}
@@ -0,0 +1,177 @@
+package algo_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+	t.Run("float32", testSum[float32])
+	t.Run("float64", testSum[float64])
+}
+
+func TestDot(t *testing.T) {
+	t.Parallel()
+	t.Run("float32", testDot[float32])
+	t.Run("float64", testDot[float64])
+}
+
+func TestSumNonFinite(t *testing.T) {
+	t.Parallel()
+
+	inf, nan := math.Inf(1), math.NaN()
+	modes := map[string]algo.SumMode{"Naive": algo.Naive, "Pairwise": algo.Pairwise, "Neumaier": algo.Neumaier}
+
+	testCases := map[string]struct {
+		input []float64
+		want  float64
+	}{
+		"positive infinity":   {input: []float64{1, inf, 1}, want: inf},
+		"negative infinity":   {input: []float64{-inf, 1e300, 1}, want: -inf},
+		"opposite infinities": {input: []float64{inf, 1, -inf}, want: nan},
+		"NaN":                 {input: []float64{1, nan, 1}, want: nan},
+		"overflow":            {input: []float64{math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64}, want: inf},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for modeName, mode := range modes {
+				requireSameFloat(t, tc.want, algo.Sum(tc.input, mode), "Wrong %s sum", modeName)
+			}
+		})
+	}
+
+	requireSameFloat(t, inf, algo.Dot([]float64{inf, 1}, []float64{1, 1}), "Wrong dot product with an infinity")
+	requireSameFloat(t, -inf, algo.Dot([]float64{1, 2}, []float64{1e308, -inf}), "Wrong dot product with an infinity")
+	requireSameFloat(t, nan, algo.Dot([]float64{inf, 1}, []float64{0, 1}), "Wrong dot product of an infinity and zero")
+}
+
+func TestSumUnknownMode(t *testing.T) {
+	t.Parallel()
+	require.Panics(t, func() { algo.Sum([]float64{1, 2}, algo.SumMode(42)) })
+}
+
+func testSum[T constraints.Float](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []T
+
+		// Whether pairwise summation should be exact too.
+		pairwiseExact bool
+	}{
+		"empty":             {input: []T{}, pairwiseExact: true},
+		"single":            {input: []T{0.1}, pairwiseExact: true},
+		"cancellation":      {input: []T{1, 1e30, 1, -1e30}},
+		"tenths":            {input: repeat[T](0.1, 1_000_000)},
+		"growing magnitude": {input: sumInput[T](10_000, func(rng *rand.Rand) float64 { return rng.Float64() * math.Pow(10, float64(rng.Intn(12))) })},
+		"mixed signs":       {input: sumInput[T](10_000, func(rng *rand.Rand) float64 { return rng.NormFloat64() * 1e6 })},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ref, absSum := exactSum(tc.input)
+			eps := epsilon[T]()
+			n := float64(len(tc.input))
+
+			// Error bounds, from Higham's Accuracy and Stability of Numerical Algorithms.
+			neumaierBound := 2*eps*math.Abs(ref) + 2*n*eps*eps*absSum
+			pairwiseBound := eps * math.Ceil(math.Log2(n+1)+1) * absSum
+			naiveBound := eps * n * absSum
+
+			neumaier := float64(algo.Sum(tc.input, algo.Neumaier))
+			pairwise := float64(algo.Sum(tc.input, algo.Pairwise))
+			naive := float64(algo.Sum(tc.input, algo.Naive))
+
+			require.LessOrEqual(t, math.Abs(neumaier-ref), neumaierBound, "Neumaier summation is not accurate enough: got %g, want %g", neumaier, ref)
+			require.LessOrEqual(t, math.Abs(pairwise-ref), pairwiseBound, "Pairwise summation is not accurate enough: got %g, want %g", pairwise, ref)
+			require.LessOrEqual(t, math.Abs(naive-ref), naiveBound, "Naive summation is not accurate enough: got %g, want %g", naive, ref)
+
+			if tc.pairwiseExact {
+				require.Equal(t, ref, pairwise, "Pairwise summation should be exact")
+			}
+		})
+	}
+
+	// A well-known case where compensation makes all the difference.
+	require.Equal(t, T(2), algo.Sum([]T{1, 1e30, 1, -1e30}, algo.Neumaier), "Neumaier summation should be exact")
+	require.Equal(t, T(0), algo.Sum([]T{1, 1e30, 1, -1e30}, algo.Naive), "Naive summation should lose the ones")
+}
+
+func testDot[T constraints.Float](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		u, v []T
+		want T
+	}{
+		"empty":            {u: []T{}, v: []T{}, want: 0},
+		"different length": {u: []T{1, 2, 3}, v: []T{4, 5}, want: 14},
+		"cancellation":     {u: []T{1e20, 1, -1e20}, v: []T{1, 1, 1}, want: 1},
+		// 1+ε and 1-ε multiply to 1-ε², which rounds to 1.
+		"product rounding": {u: []T{1 + T(epsilon[T]()), -1}, v: []T{1 - T(epsilon[T]()), 1}, want: -T(epsilon[T]() * epsilon[T]())},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, algo.Dot(tc.u, tc.v))
+		})
+	}
+}
+
+// requireSameFloat checks that got is equal to want, where NaN is equal to itself.
+func requireSameFloat(t *testing.T, want, got float64, msgAndArgs ...any) {
+	t.Helper()
+	if math.IsNaN(want) {
+		require.True(t, math.IsNaN(got), msgAndArgs...)
+		return
+	}
+	require.Equal(t, want, got, msgAndArgs...)
+}
+
+// exactSum returns the sum of the input rounded to float64, and the sum of its
+// absolute values, computed with enough precision to be exact.
+func exactSum[T constraints.Float](arr []T) (sum, absSum float64) {
+	s := new(big.Float).SetPrec(2048)
+	a := new(big.Float).SetPrec(2048)
+	for _, x := range arr {
+		s.Add(s, big.NewFloat(float64(x)))
+		a.Add(a, big.NewFloat(math.Abs(float64(x))))
+	}
+	sum, _ = s.Float64()
+	absSum, _ = a.Float64()
+	return sum, absSum
+}
+
+// epsilon is the difference between 1 and the next representable number.
+func epsilon[T constraints.Float]() float64 {
+	var x T = 1
+	switch any(x).(type) {
+	case float32:
+		return float64(math.Nextafter32(1, 2) - 1)
+	default:
+		return math.Nextafter(1, 2) - 1
+	}
+}
+
+func repeat[T any](x T, n int) []T {
+	return algo.Generate(n, func() T { return x })
+}
+
+func sumInput[T constraints.Float](n int, f func(*rand.Rand) float64) []T {
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	return algo.Generate(n, func() T { return T(f(rng)) })
+}
@@ -0,0 +1,191 @@
+package algo
+
+import (
+	"github.com/EduardGomezEscandell/algo/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// Windows returns every window of the given size in arr, starting one every
+// step items. Windows that would extend past the end of arr are left out.
+// The windows share memory with arr, but appending to them does not modify
+// it. It panics if size or step are not positive.
+//
+// Example: Windows([]int{1, 2, 3, 4, 5}, 3, 2) returns [[1, 2, 3], [3, 4, 5]].
+//
+// Complexity is O(|arr|/step).
+func Windows[T any](arr []T, size, step int) [][]T {
+	validateWindow(size)
+	if step <= 0 {
+		panic("step must be positive")
+	}
+	if len(arr) < size {
+		return [][]T{}
+	}
+
+	out := make([][]T, (len(arr)-size)/step+1)
+	for i := range out {
+		out[i] = arr[i*step : i*step+size : i*step+size]
+	}
+	return out
+}
+
+// Chunk splits arr into consecutive slices of n items. The last one is
+// shorter if the length of arr is not a multiple of n. The chunks share
+// memory with arr, but appending to them does not modify it. It panics if n
+// is not positive.
+//
+// Complexity is O(|arr|/n).
+func Chunk[T any](arr []T, n int) [][]T {
+	validateWindow(n)
+
+	out := make([][]T, 0, (len(arr)+n-1)/n)
+	for begin := 0; begin < len(arr); begin += n {
+		end := utils.Min(begin+n, len(arr))
+		out = append(out, arr[begin:end:end])
+	}
+	return out
+}
+
+// WindowReduce slides a window of the given size across arr, one item at a
+// time, and reduces every window with fold, starting from init. The output
+// has length len(arr)-size+1, or zero if arr is shorter than the window.
+// It panics if size is not positive.
+//
+// Complexity is O(|arr|·size).
+func WindowReduce[T, O any](arr []T, size int, fold func(O, T) O, init O) []O {
+	validateWindow(size)
+	if len(arr) < size {
+		return []O{}
+	}
+
+	out := make([]O, len(arr)-size+1)
+	for i := range out {
+		out[i] = Reduce(arr[i:i+size], fold, init)
+	}
+	return out
+}
+
+// SlidingMin slides a window of the given size across arr, one item at a
+// time, and returns the minimum of every window. The output has length
+// len(arr)-size+1, or zero if arr is shorter than the window. It panics if
+// size is not positive.
+//
+// It keeps the candidates to be the minimum in a monotonic queue, so every
+// item is compared a constant number of times on average.
+//
+// Complexity is O(|arr|).
+func SlidingMin[T constraints.Ordered](arr []T, size int) []T {
+	return slidingFirst(arr, size, func(x, y T) bool { return x < y })
+}
+
+// SlidingMax is the same as SlidingMin, but it returns the maximum of every
+// window.
+//
+// Complexity is O(|arr|).
+func SlidingMax[T constraints.Ordered](arr []T, size int) []T {
+	return slidingFirst(arr, size, func(x, y T) bool { return x > y })
+}
+
+// RollingMean slides a window of the given size across arr, one item at a
+// time, and returns the mean of every window. The output has length
+// len(arr)-size+1, or zero if arr is shorter than the window. It panics if
+// size is not positive.
+//
+// Complexity is O(|arr|).
+func RollingMean[T utils.Number](arr []T, size int) []float64 {
+	means, _ := rollingMoments(arr, size)
+	return means
+}
+
+// RollingVariance slides a window of the given size across arr, one item at
+// a time, and returns the population variance of every window, i.e. the mean
+// squared distance to the mean of the window. The output has length
+// len(arr)-size+1, or zero if arr is shorter than the window. It panics if
+// size is not positive.
+//
+// It uses Welford's algorithm, which is less prone to cancellation than
+// subtracting the square of the mean from the mean of the squares.
+//
+// Complexity is O(|arr|).
+func RollingVariance[T utils.Number](arr []T, size int) []float64 {
+	_, variances := rollingMoments(arr, size)
+	return variances
+}
+
+// slidingFirst returns the first item of every window according to comp.
+func slidingFirst[T any](arr []T, size int, comp utils.Comparator[T]) []T {
+	validateWindow(size)
+	if len(arr) < size {
+		return []T{}
+	}
+
+	// Indices of the items that can still be the first of a window, such that
+	// each of them goes before the ones after it. Its head is the first of the
+	// current window.
+	queue := make([]int, 0, len(arr))
+	head := 0
+
+	out := make([]T, len(arr)-size+1)
+	for i, x := range arr {
+		for len(queue) > head && !comp(arr[queue[len(queue)-1]], x) {
+			queue = queue[:len(queue)-1]
+		}
+		queue = append(queue, i)
+
+		if queue[head] <= i-size {
+			head++
+		}
+		if i >= size-1 {
+			out[i-size+1] = arr[queue[head]]
+		}
+	}
+	return out
+}
+
+// rollingMoments returns the mean and the population variance of every window.
+func rollingMoments[T utils.Number](arr []T, size int) (means, variances []float64) {
+	validateWindow(size)
+	if len(arr) < size {
+		return []float64{}, []float64{}
+	}
+
+	means = make([]float64, len(arr)-size+1)
+	variances = make([]float64, len(arr)-size+1)
+
+	var mean, m2 float64
+	for i := range means {
+		if i%size == 0 {
+			// Rounding errors add up with every update, so the window is
+			// computed from scratch every once in a while.
+			mean, m2 = windowMoments(arr[i : i+size])
+		} else {
+			// The window replaces one item with another.
+			out, in := float64(arr[i-1]), float64(arr[i+size-1])
+			oldMean := mean
+			mean += (in - out) / float64(size)
+			m2 += (in - out) * (in - mean + out - oldMean)
+
+			// Rounding errors can make it slightly negative.
+			m2 = utils.Max(m2, 0)
+		}
+		means[i], variances[i] = mean, m2/float64(size)
+	}
+	return means, variances
+}
+
+// windowMoments returns the mean of the window, and the sum of the squares of
+// the distances to it, with Welford's algorithm.
+func windowMoments[T utils.Number](window []T) (mean, m2 float64) {
+	for i, x := range window {
+		delta := float64(x) - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (float64(x) - mean)
+	}
+	return mean, m2
+}
+
+func validateWindow(size int) {
+	if size <= 0 {
+		panic("window size must be positive")
+	}
+}
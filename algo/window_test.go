@@ -0,0 +1,114 @@
+package algo_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindows(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 3, 4, 5}
+
+	testCases := map[string]struct {
+		got  [][]int
+		want [][]int
+	}{
+		"Windows":               {got: algo.Windows(input, 3, 2), want: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		"Windows, step 1":       {got: algo.Windows(input, 4, 1), want: [][]int{{1, 2, 3, 4}, {2, 3, 4, 5}}},
+		"Windows, large step":   {got: algo.Windows(input, 2, 4), want: [][]int{{1, 2}}},
+		"Windows, gaps":         {got: algo.Windows(input, 1, 2), want: [][]int{{1}, {3}, {5}}},
+		"Windows, whole array":  {got: algo.Windows(input, 5, 1), want: [][]int{input}},
+		"Windows, too large":    {got: algo.Windows(input, 6, 1), want: [][]int{}},
+		"Windows, empty":        {got: algo.Windows([]int{}, 1, 1), want: [][]int{}},
+		"Chunk":                 {got: algo.Chunk(input, 2), want: [][]int{{1, 2}, {3, 4}, {5}}},
+		"Chunk, exact":          {got: algo.Chunk(input, 5), want: [][]int{input}},
+		"Chunk, larger":         {got: algo.Chunk(input, 8), want: [][]int{input}},
+		"Chunk, single items":   {got: algo.Chunk(input, 1), want: [][]int{{1}, {2}, {3}, {4}, {5}}},
+		"Chunk, empty":          {got: algo.Chunk([]int{}, 3), want: [][]int{}},
+		"WindowReduce, as sums": {got: [][]int{algo.WindowReduce(input, 2, utils.Add[int], 0)}, want: [][]int{{3, 5, 7, 9}}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, tc.got)
+		})
+	}
+
+	// Appending to a window must not overwrite the original array.
+	arr := []int{1, 2, 3, 4}
+	w := algo.Windows(arr, 2, 2)[0]
+	_ = append(w, 42)
+	c := algo.Chunk(arr, 2)[0]
+	_ = append(c, 42)
+	require.Equal(t, []int{1, 2, 3, 4}, arr, "Appending to a window modified the array")
+
+	require.Panics(t, func() { algo.Windows(input, 0, 1) })
+	require.Panics(t, func() { algo.Windows(input, 1, 0) })
+	require.Panics(t, func() { algo.Chunk(input, 0) })
+	require.Panics(t, func() { algo.WindowReduce(input, -1, utils.Add[int], 0) })
+	require.Panics(t, func() { algo.SlidingMin(input, 0) })
+	require.Panics(t, func() { algo.RollingMean(input, 0) })
+}
+
+func TestSlidingStatistics(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 1, 2, 10, 1000} {
+		for _, window := range []int{1, 2, 3, 7, 100} {
+			t.Run(fmt.Sprintf("size %d, window %d", size, window), func(t *testing.T) {
+				t.Parallel()
+
+				rng := rand.New(rand.NewSource(int64(size * window))) //nolint: gosec // Deterministic tests.
+				input := algo.Generate(size, func() int { return rng.Intn(100) })
+
+				minimum := func(acc, x int) int { return utils.Min(acc, x) }
+				maximum := func(acc, x int) int { return utils.Max(acc, x) }
+				require.Equal(t, algo.WindowReduce(input, window, minimum, math.MaxInt), algo.SlidingMin(input, window), "Wrong SlidingMin")
+				require.Equal(t, algo.WindowReduce(input, window, maximum, math.MinInt), algo.SlidingMax(input, window), "Wrong SlidingMax")
+
+				wantMean, wantVar := naiveMoments(input, window)
+				requireInDeltaSlice(t, wantMean, algo.RollingMean(input, window), 1e-9, "Wrong RollingMean")
+				requireInDeltaSlice(t, wantVar, algo.RollingVariance(input, window), 1e-9, "Wrong RollingVariance")
+			})
+		}
+	}
+}
+
+func TestRollingVarianceIsStable(t *testing.T) {
+	t.Parallel()
+
+	// Small variations on a large offset, where the mean of the squares minus
+	// the square of the mean would lose all significant digits.
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	input := algo.Generate(100_000, func() float64 { return 1e9 + rng.Float64() })
+
+	wantMean, wantVar := naiveMoments(input, 50)
+	requireInDeltaSlice(t, wantMean, algo.RollingMean(input, 50), 1e-5, "Wrong RollingMean")
+	requireInDeltaSlice(t, wantVar, algo.RollingVariance(input, 50), 1e-5, "Wrong RollingVariance")
+}
+
+// naiveMoments computes the mean and population variance of every window with two passes.
+func naiveMoments[T utils.Number](arr []T, size int) (means, variances []float64) {
+	windows := algo.Windows(algo.Map(arr, func(x T) float64 { return float64(x) }), size, 1)
+	means = algo.Map(windows, func(w []float64) float64 { return algo.Sum(w, algo.Neumaier) / float64(size) })
+	variances = algo.ZipWith(windows, means, func(w []float64, mean float64) float64 {
+		return algo.Sum(algo.Map(w, func(x float64) float64 { return (x - mean) * (x - mean) }), algo.Neumaier) / float64(size)
+	})
+	return means, variances
+}
+
+func requireInDeltaSlice(t *testing.T, want, got []float64, delta float64, msg string) {
+	t.Helper()
+	require.Len(t, got, len(want), msg)
+	for i := range want {
+		require.InDelta(t, want[i], got[i], delta, "%s: mismatch at index %d", msg, i)
+	}
+}
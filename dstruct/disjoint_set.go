@@ -0,0 +1,77 @@
+package dstruct
+
+// DisjointSet implements a union-find data structure over the
+// integers in the range [0, n). Every integer starts in its own
+// set, and sets can be merged together.
+type DisjointSet struct {
+	parent []int
+	rank   []int
+	sets   int
+}
+
+// NewDisjointSet creates a disjoint set of n singletons.
+func NewDisjointSet(n int) DisjointSet {
+	d := DisjointSet{
+		parent: make([]int, n),
+		rank:   make([]int, n),
+		sets:   n,
+	}
+	for i := range d.parent {
+		d.parent[i] = i
+	}
+	return d
+}
+
+// Len is the count of elements in the disjoint set.
+func (d DisjointSet) Len() int {
+	return len(d.parent)
+}
+
+// Sets is the count of disjoint sets.
+func (d DisjointSet) Sets() int {
+	return d.sets
+}
+
+// Find returns the representative of the set x belongs to. Two
+// elements belong to the same set if, and only if, they have the
+// same representative.
+//
+// Complexity is amortized O(α(n)), where α is the inverse Ackermann function.
+func (d *DisjointSet) Find(x int) int {
+	root := x
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	// Path compression.
+	for d.parent[x] != root {
+		d.parent[x], x = root, d.parent[x]
+	}
+	return root
+}
+
+// Union merges the sets x and y belong to. It returns false if they
+// already were in the same set.
+//
+// Complexity is amortized O(α(n)), where α is the inverse Ackermann function.
+func (d *DisjointSet) Union(x, y int) bool {
+	x, y = d.Find(x), d.Find(y)
+	if x == y {
+		return false
+	}
+
+	// Union by rank.
+	if d.rank[x] < d.rank[y] {
+		x, y = y, x
+	}
+	d.parent[y] = x
+	if d.rank[x] == d.rank[y] {
+		d.rank[x]++
+	}
+	d.sets--
+	return true
+}
+
+// Connected returns true if x and y belong to the same set.
+func (d *DisjointSet) Connected(x, y int) bool {
+	return d.Find(x) == d.Find(y)
+}
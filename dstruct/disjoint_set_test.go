@@ -0,0 +1,38 @@
+package dstruct_test
+
+import (
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/dstruct"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisjointSet(t *testing.T) {
+	t.Parallel()
+
+	d := dstruct.NewDisjointSet(6)
+	require.Equal(t, 6, d.Len())
+	require.Equal(t, 6, d.Sets())
+
+	for i := 0; i < d.Len(); i++ {
+		require.Equal(t, i, d.Find(i), "Singleton should be its own representative")
+	}
+
+	require.True(t, d.Union(0, 1))
+	require.True(t, d.Union(2, 3))
+	require.True(t, d.Union(3, 4))
+	require.False(t, d.Union(2, 4), "Unexpected success merging elements in the same set")
+	require.Equal(t, 3, d.Sets())
+
+	require.True(t, d.Connected(0, 1))
+	require.True(t, d.Connected(2, 4))
+	require.False(t, d.Connected(1, 2))
+	require.False(t, d.Connected(5, 0))
+
+	require.True(t, d.Union(1, 4))
+	require.Equal(t, 2, d.Sets())
+	for i := 1; i < 5; i++ {
+		require.Equal(t, d.Find(0), d.Find(i), "Elements in the same set have different representatives")
+	}
+	require.NotEqual(t, d.Find(0), d.Find(5))
+}
@@ -1,10 +1,6 @@
 // Package dstruct implements various Data STRUCTures.
 package dstruct
 
-import (
-	"github.com/EduardGomezEscandell/algo/algo"
-)
-
 // Stack data structure. Implements a LIFO queue.
 type Stack[T any] struct {
 	data []T
@@ -68,5 +64,10 @@ func (s *Stack[T]) Data() []T {
 
 // Invert reverses the order of the items within the stack.
 func (s *Stack[T]) Invert() {
-	s.data = algo.Reverse(s.data)
+	// The items are copied, so slices returned by Data are not modified.
+	reversed := make([]T, 0, len(s.data))
+	for j := len(s.data) - 1; j >= 0; j-- {
+		reversed = append(reversed, s.data[j])
+	}
+	s.data = reversed
 }
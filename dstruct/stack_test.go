@@ -38,6 +38,12 @@ func testStack[T utils.Signed](t *testing.T) { //nolint: thelper
 			require.Equal(t, tc.input, s.Data())
 			require.Equal(t, len(tc.input), s.Size())
 
+			data := s.Data()
+			s.Invert()
+			require.Equal(t, tc.input, data, "Invert should not modify slices returned by Data")
+			s.Invert()
+			require.Equal(t, tc.input, s.Data())
+
 			for range tc.input {
 				s.Pop()
 			}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// ErrCycle is returned when an algorithm requires a directed acyclic graph,
+// but the graph contains a cycle.
+var ErrCycle = errors.New("graph contains a cycle")
+
+// TransitiveClosure computes the reachability matrix of the graph, where
+// reach[i][j] is true if, and only if, there is a path of one or more edges
+// from node i to node j. Hence, reach[i][i] is only true if node i is part
+// of a cycle. Rows of the matrix are updated in parallel.
+//
+// Complexity is O(|V|³).
+func TransitiveClosure[W utils.Number](g *Graph[W]) (reach [][]bool) {
+	n := g.Len()
+	reach = make([][]bool, n)
+	for i := range reach {
+		reach[i] = make([]bool, n)
+	}
+	for _, e := range g.edges {
+		reach[e.From][e.To] = true
+	}
+
+	dist := palgo.NewWorkDistribution(n, 3)
+	for k := 0; k < n; k++ {
+		rk := reach[k]
+		dist.Run(func(w palgo.WorkAlloc) {
+			for i := w.Begin; i < w.End; i++ {
+				// Row k cannot change during iteration k, so it is
+				// not written to avoid racing with other workers.
+				if i == k || !reach[i][k] {
+					continue
+				}
+				ri := reach[i]
+				for j, r := range rk {
+					if r {
+						ri[j] = true
+					}
+				}
+			}
+		})
+	}
+
+	return reach
+}
+
+// TransitiveReduction computes the transitive reduction of a directed acyclic
+// graph: the smallest subset of its edges that preserves reachability between
+// every pair of nodes. Out of several parallel edges, the one with the smallest
+// ID is kept.
+//
+// It returns the edges in the reduction, sorted by ID, or ErrCycle if the graph
+// is not acyclic.
+//
+// Complexity is O(|V|³).
+func TransitiveReduction[W utils.Number](g *Graph[W]) ([]Edge[W], error) {
+	reach := TransitiveClosure(g)
+	for i := range reach {
+		if reach[i][i] {
+			return nil, ErrCycle
+		}
+	}
+
+	reduction := []Edge[W]{}
+	kept := make(map[[2]int]bool)
+	for _, e := range g.edges {
+		if kept[[2]int{e.From, e.To}] {
+			continue
+		}
+
+		// The edge is redundant if its head can be reached via another successor.
+		redundant := false
+		for _, id := range g.out[e.From] {
+			if w := g.edges[id].To; w != e.To && reach[w][e.To] {
+				redundant = true
+				break
+			}
+		}
+		if redundant {
+			continue
+		}
+
+		kept[[2]int{e.From, e.To}] = true
+		reduction = append(reduction, e)
+	}
+
+	return reduction, nil
+}
@@ -0,0 +1,109 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitiveClosure(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nodes int
+		edges [][3]int
+	}{
+		"empty":        {nodes: 0},
+		"single":       {nodes: 1},
+		"self loop":    {nodes: 2, edges: [][3]int{{0, 0, 1}, {0, 1, 1}}},
+		"chain":        {nodes: 4, edges: [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}}},
+		"cycle":        {nodes: 4, edges: [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {2, 3, 1}}},
+		"disconnected": {nodes: 4, edges: [][3]int{{0, 1, 1}, {3, 2, 1}}},
+		"random":       {nodes: 50, edges: randomEdges(42, 50, 100, 0, 1)},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.New[int](tc.nodes)
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1], e[2])
+			}
+
+			reach := graph.TransitiveClosure(g)
+			require.Len(t, reach, tc.nodes)
+			for i := 0; i < tc.nodes; i++ {
+				want := reachableInOneOrMore(g, i)
+				require.Equal(t, want, reach[i], "Wrong reachability from node %d", i)
+			}
+		})
+	}
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nodes   int
+		edges   [][3]int
+		want    []int
+		wantErr error
+	}{
+		"empty":     {nodes: 0, want: []int{}},
+		"single":    {nodes: 2, edges: [][3]int{{0, 1, 1}}, want: []int{0}},
+		"parallel":  {nodes: 2, edges: [][3]int{{0, 1, 1}, {0, 1, 2}}, want: []int{0}},
+		"triangle":  {nodes: 3, edges: [][3]int{{0, 1, 1}, {1, 2, 1}, {0, 2, 1}}, want: []int{0, 1}},
+		"diamond":   {nodes: 4, edges: [][3]int{{0, 1, 1}, {0, 2, 1}, {1, 3, 1}, {2, 3, 1}, {0, 3, 1}}, want: []int{0, 1, 2, 3}},
+		"long skip": {nodes: 4, edges: [][3]int{{0, 3, 1}, {0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {1, 3, 1}}, want: []int{1, 2, 3}},
+		"cycle":     {nodes: 3, edges: [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}}, wantErr: graph.ErrCycle},
+		"self loop": {nodes: 1, edges: [][3]int{{0, 0, 1}}, wantErr: graph.ErrCycle},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.New[int](tc.nodes)
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1], e[2])
+			}
+
+			reduction, err := graph.TransitiveReduction(g)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := make([]int, 0, len(reduction))
+			reduced := graph.New[int](tc.nodes)
+			for _, e := range reduction {
+				ids = append(ids, e.ID)
+				reduced.AddEdge(e.From, e.To, e.Weight)
+			}
+			require.Equal(t, tc.want, ids)
+			require.Equal(t, graph.TransitiveClosure(g), graph.TransitiveClosure(reduced), "Reduction does not preserve reachability")
+		})
+	}
+}
+
+// reachableInOneOrMore returns which nodes can be reached from the source with at least one edge.
+func reachableInOneOrMore(g *graph.Graph[int], source int) []bool {
+	reached := make([]bool, g.Len())
+	stack := []int{source}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range g.Out(u) {
+			if !reached[e.To] {
+				reached[e.To] = true
+				stack = append(stack, e.To)
+			}
+		}
+	}
+	return reached
+}
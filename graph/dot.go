@@ -0,0 +1,558 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// WriteDOT writes the graph in the Graphviz DOT language. Nodes are named after
+// their ID, and edges are written in order of ID. The attributes provided by the
+// encoder are attached to every node and edge, alongside the edge weights.
+func WriteDOT[W utils.Number](w io.Writer, g *Graph[W], enc Encoder[W]) error {
+	ew := &errWriter{w: w}
+
+	ew.printf("digraph {\n")
+	for n := 0; n < g.Len(); n++ {
+		ew.printf("\t%d%s;\n", n, dotAttributes(enc.node(n)))
+	}
+	for _, e := range g.edges {
+		attr := enc.edge(e)
+		attr[weightKey] = formatWeight(e.Weight)
+		ew.printf("\t%d -> %d%s;\n", e.From, e.To, dotAttributes(attr))
+	}
+	ew.printf("}\n")
+
+	return ew.err
+}
+
+// ReadDOT reads a graph written in the Graphviz DOT language. Nodes are numbered
+// in order of first appearance, and edges in order of appearance. The attributes
+// of every node and edge are passed to the decoder. Edge weights are read from
+// the "weight" attribute, and default to zero.
+//
+// Only a subset of the language is supported: subgraphs and ports are not.
+// Directed and undirected graphs are both accepted, but every edge is added
+// from left to right.
+func ReadDOT[W utils.Number](r io.Reader, dec Decoder[W]) (*Graph[W], error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dotParser{lex: dotLexer{src: string(src), line: 1}}
+	if err := p.parse(); err != nil {
+		return nil, fmt.Errorf("could not parse DOT: %v", err)
+	}
+
+	g := New[W](len(p.nodes))
+	for n, node := range p.nodes {
+		if err := dec.node(n, node.name, node.attr); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range p.edges {
+		if err := dec.edge(g, e.from, e.to, e.attr); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+var dotPlainID = regexp.MustCompile(`^([A-Za-z_\x{80}-\x{10FFFF}][A-Za-z_0-9\x{80}-\x{10FFFF}]*|-?([0-9]+(\.[0-9]*)?|\.[0-9]+))$`)
+
+// dotID quotes a string unless it is a valid unquoted DOT identifier.
+func dotID(s string) string {
+	if dotPlainID.MatchString(s) && !isDOTKeyword(s) {
+		return s
+	}
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+func dotAttributes(attr Attributes) string {
+	if len(attr) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(" [")
+	for i, k := range sortedKeys(attr) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s=%s", dotID(k), dotID(attr[k]))
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func isDOTKeyword(s string) bool {
+	switch strings.ToLower(s) {
+	case "node", "edge", "graph", "digraph", "subgraph", "strict":
+		return true
+	}
+	return false
+}
+
+type dotTokenKind int
+
+const (
+	dotEOF    dotTokenKind = iota
+	dotIdent               // An identifier, numeral, or string.
+	dotPunct               // One of {}[];,=:
+	dotEdgeOp              // Either -> or --
+)
+
+type dotToken struct {
+	kind   dotTokenKind
+	text   string
+	line   int
+	quoted bool // Quoted and HTML strings are never keywords.
+}
+
+// isKeyword returns true if the token is the given keyword. Like in DOT,
+// keywords are case-insensitive, and quoting them makes them identifiers.
+func (t dotToken) isKeyword(keyword string) bool {
+	return t.kind == dotIdent && !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+// dotLexer splits DOT source code into tokens.
+type dotLexer struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (l *dotLexer) next() (dotToken, error) {
+	if err := l.skipBlank(); err != nil {
+		return dotToken{}, err
+	}
+	if l.pos == len(l.src) {
+		return dotToken{kind: dotEOF, line: l.line}, nil
+	}
+
+	line := l.line
+	c := l.src[l.pos]
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "->"), strings.HasPrefix(l.src[l.pos:], "--"):
+		l.pos += 2
+		return dotToken{kind: dotEdgeOp, text: l.src[l.pos-2 : l.pos], line: line}, nil
+	case strings.ContainsRune("{}[];,=:", rune(c)):
+		l.pos++
+		return dotToken{kind: dotPunct, text: string(c), line: line}, nil
+	case c == '"':
+		s, err := l.quoted()
+		return dotToken{kind: dotIdent, text: s, line: line, quoted: true}, err
+	case c == '<':
+		s, err := l.html()
+		return dotToken{kind: dotIdent, text: s, line: line, quoted: true}, err
+	}
+
+	if m := dotPlainID.FindString(l.scanWord()); m != "" {
+		l.pos += len(m)
+		return dotToken{kind: dotIdent, text: m, line: line}, nil
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+	return dotToken{}, fmt.Errorf("line %d: unexpected character %q", line, r)
+}
+
+// scanWord returns the longest prefix of the remaining source made of characters
+// that can be part of an unquoted identifier.
+func (l *dotLexer) scanWord() string {
+	end := l.pos
+	for end < len(l.src) {
+		c := l.src[end]
+		if c != '_' && c != '.' && c != '-' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9') && c < utf8.RuneSelf {
+			break
+		}
+		if c == '-' && end > l.pos {
+			break // A dash can only start a numeral.
+		}
+		end++
+	}
+	return l.src[l.pos:end]
+}
+
+func (l *dotLexer) skipBlank() error {
+	for l.pos < len(l.src) {
+		rest := l.src[l.pos:]
+		switch {
+		case rest[0] == '\n':
+			l.line++
+			l.pos++
+		case rest[0] == ' ', rest[0] == '\t', rest[0] == '\r':
+			l.pos++
+		case rest[0] == '#', strings.HasPrefix(rest, "//"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			l.pos += end
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest, "*/")
+			if end < 0 {
+				return fmt.Errorf("line %d: unterminated comment", l.line)
+			}
+			l.line += strings.Count(rest[:end], "\n")
+			l.pos += end + 2
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// quoted reads a double-quoted string. Escaped quotes and backslashes are
+// unescaped, and escaped newlines are removed. Other escapes are kept as-is.
+func (l *dotLexer) quoted() (string, error) {
+	line := l.line
+	var b strings.Builder
+	for i := l.pos + 1; i < len(l.src); i++ {
+		c := l.src[i]
+		switch {
+		case c == '"':
+			l.pos = i + 1
+			return b.String(), nil
+		case c == '\\' && i+1 < len(l.src):
+			i++
+			switch l.src[i] {
+			case '"', '\\':
+				b.WriteByte(l.src[i])
+			case '\n':
+				l.line++
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(l.src[i])
+			}
+			continue
+		case c == '\n':
+			l.line++
+		}
+		b.WriteByte(c)
+	}
+	return "", fmt.Errorf("line %d: unterminated string", line)
+}
+
+// html reads an HTML string, delimited by balanced angle brackets.
+func (l *dotLexer) html() (string, error) {
+	line := l.line
+	depth := 0
+	for i := l.pos; i < len(l.src); i++ {
+		switch l.src[i] {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case '\n':
+			l.line++
+		}
+		if depth == 0 {
+			s := l.src[l.pos+1 : i]
+			l.pos = i + 1
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("line %d: unterminated HTML string", line)
+}
+
+type dotNode struct {
+	name string
+	attr Attributes
+}
+
+type dotEdge struct {
+	from, to int
+	attr     Attributes
+}
+
+// dotParser reads the statements in a DOT graph.
+type dotParser struct {
+	lex    dotLexer
+	peeked *dotToken
+
+	nodes []dotNode
+	edges []dotEdge
+	ids   map[string]int
+
+	nodeDefaults, edgeDefaults Attributes
+}
+
+func (p *dotParser) next() (dotToken, error) {
+	if p.peeked != nil {
+		t := *p.peeked
+		p.peeked = nil
+		return t, nil
+	}
+	return p.lex.next()
+}
+
+func (p *dotParser) peek() (dotToken, error) {
+	if p.peeked == nil {
+		t, err := p.lex.next()
+		if err != nil {
+			return t, err
+		}
+		p.peeked = &t
+	}
+	return *p.peeked, nil
+}
+
+// expect consumes the next token, and fails unless it is the specified punctuation.
+func (p *dotParser) expect(punct string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != dotPunct || t.text != punct {
+		return unexpected(t, punct)
+	}
+	return nil
+}
+
+func unexpected(t dotToken, want string) error {
+	if t.kind == dotEOF {
+		return fmt.Errorf("line %d: unexpected end of file, expected %s", t.line, want)
+	}
+	return fmt.Errorf("line %d: unexpected %q, expected %s", t.line, t.text, want)
+}
+
+func (p *dotParser) parse() error {
+	p.ids = make(map[string]int)
+	p.nodeDefaults = Attributes{}
+	p.edgeDefaults = Attributes{}
+
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.isKeyword("strict") {
+		if t, err = p.next(); err != nil {
+			return err
+		}
+	}
+	if !t.isKeyword("graph") && !t.isKeyword("digraph") {
+		return unexpected(t, "graph or digraph")
+	}
+
+	// Optional graph name.
+	if t, err = p.peek(); err != nil {
+		return err
+	}
+	if t.kind == dotIdent {
+		_, _ = p.next()
+	}
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for {
+		t, err := p.peek()
+		if err != nil {
+			return err
+		}
+		if t.kind == dotPunct && t.text == "}" {
+			_, _ = p.next()
+			break
+		}
+		if t.kind == dotPunct && t.text == ";" {
+			_, _ = p.next()
+			continue
+		}
+		if err := p.statement(); err != nil {
+			return err
+		}
+	}
+
+	if t, err := p.next(); err != nil {
+		return err
+	} else if t.kind != dotEOF {
+		return unexpected(t, "end of file")
+	}
+	return nil
+}
+
+func (p *dotParser) statement() error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != dotIdent {
+		return unexpected(t, "a statement")
+	}
+
+	switch {
+	case t.isKeyword("subgraph"):
+		return fmt.Errorf("line %d: subgraphs are not supported", t.line)
+	case t.isKeyword("graph"):
+		_, err := p.attributes() // Graph attributes are ignored.
+		return err
+	case t.isKeyword("node"):
+		return p.defaults(p.nodeDefaults)
+	case t.isKeyword("edge"):
+		return p.defaults(p.edgeDefaults)
+	}
+
+	next, err := p.peek()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case next.kind == dotPunct && next.text == "=":
+		// Graph attribute.
+		_, _ = p.next()
+		if t, err := p.next(); err != nil {
+			return err
+		} else if t.kind != dotIdent {
+			return unexpected(t, "a value")
+		}
+		return nil
+	case next.kind == dotPunct && next.text == ":":
+		return fmt.Errorf("line %d: ports are not supported", next.line)
+	case next.kind == dotEdgeOp:
+		return p.edgeStatement(t)
+	}
+
+	// Node statement.
+	n := p.node(t.text)
+	attr, err := p.attributes()
+	if err != nil {
+		return err
+	}
+	for k, v := range attr {
+		p.nodes[n].attr[k] = v
+	}
+	return nil
+}
+
+func (p *dotParser) edgeStatement(first dotToken) error {
+	chain := []int{p.node(first.text)}
+	for {
+		t, err := p.peek()
+		if err != nil {
+			return err
+		}
+		if t.kind != dotEdgeOp {
+			break
+		}
+		_, _ = p.next()
+
+		if t, err = p.next(); err != nil {
+			return err
+		}
+		if t.kind != dotIdent {
+			return unexpected(t, "a node")
+		}
+		if t.isKeyword("subgraph") {
+			return fmt.Errorf("line %d: subgraphs are not supported", t.line)
+		}
+		chain = append(chain, p.node(t.text))
+	}
+
+	attr, err := p.attributes()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(chain); i++ {
+		e := dotEdge{from: chain[i-1], to: chain[i], attr: Attributes{}}
+		for k, v := range p.edgeDefaults {
+			e.attr[k] = v
+		}
+		for k, v := range attr {
+			e.attr[k] = v
+		}
+		p.edges = append(p.edges, e)
+	}
+	return nil
+}
+
+// node returns the ID of the node with the specified name, adding it if needed.
+func (p *dotParser) node(name string) int {
+	if n, ok := p.ids[name]; ok {
+		return n
+	}
+
+	n := len(p.nodes)
+	p.ids[name] = n
+	attr := Attributes{}
+	for k, v := range p.nodeDefaults {
+		attr[k] = v
+	}
+	p.nodes = append(p.nodes, dotNode{name: name, attr: attr})
+	return n
+}
+
+func (p *dotParser) defaults(dst Attributes) error {
+	attr, err := p.attributes()
+	if err != nil {
+		return err
+	}
+	for k, v := range attr {
+		dst[k] = v
+	}
+	return nil
+}
+
+// attributes parses any number of attribute lists, and merges them.
+func (p *dotParser) attributes() (Attributes, error) {
+	attr := Attributes{}
+	for {
+		t, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind != dotPunct || t.text != "[" {
+			return attr, nil
+		}
+		_, _ = p.next()
+
+		if err := p.attributeList(attr); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attributeList parses the contents of an attribute list, including the closing bracket.
+func (p *dotParser) attributeList(attr Attributes) error {
+	for {
+		key, err := p.next()
+		if err != nil {
+			return err
+		}
+		if key.kind == dotPunct && key.text == "]" {
+			return nil
+		}
+		if key.kind != dotIdent {
+			return unexpected(key, "an attribute name")
+		}
+
+		if err := p.expect("="); err != nil {
+			return err
+		}
+
+		value, err := p.next()
+		if err != nil {
+			return err
+		}
+		if value.kind != dotIdent {
+			return unexpected(value, "an attribute value")
+		}
+		attr[key.text] = value.text
+
+		if sep, err := p.peek(); err != nil {
+			return err
+		} else if sep.kind == dotPunct && (sep.text == "," || sep.text == ";") {
+			_, _ = p.next()
+		}
+	}
+}
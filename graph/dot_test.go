@@ -0,0 +1,151 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDOT(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     string
+		wantNames []string
+		wantNodes []graph.Attributes
+		wantEdges []graph.Edge[float64]
+		wantAttr  []graph.Attributes
+		wantErr   bool
+	}{
+		"empty": {input: "digraph {}", wantNames: []string{}, wantNodes: []graph.Attributes{}, wantAttr: []graph.Attributes{}},
+		"named": {input: "strict digraph G { a -> b }", wantNames: []string{"a", "b"},
+			wantNodes: []graph.Attributes{{}, {}},
+			wantEdges: []graph.Edge[float64]{{ID: 0, From: 0, To: 1}},
+			wantAttr:  []graph.Attributes{{}},
+		},
+		"undirected": {input: "graph { a -- b -- c; }", wantNames: []string{"a", "b", "c"},
+			wantNodes: []graph.Attributes{{}, {}, {}},
+			wantEdges: []graph.Edge[float64]{{ID: 0, From: 0, To: 1}, {ID: 1, From: 1, To: 2}},
+			wantAttr:  []graph.Attributes{{}, {}},
+		},
+		"attributes": {
+			input: `digraph {
+				// Comments are ignored.
+				rankdir=LR
+				graph [bgcolor=white]
+				node [shape=box]
+				a [label="A"]
+				/* Multi-line
+				   comment */
+				a -> b [weight=2.5, color=red][style=dashed];
+				edge [color=blue]
+				# Preprocessor-like comment.
+				b -> "c d" [label=<<b>bold</b>>, weight=-1]
+				b [label="B \"quoted\"", shape=circle]
+			}`,
+			wantNames: []string{"a", "b", "c d"},
+			wantNodes: []graph.Attributes{
+				{"shape": "box", "label": "A"},
+				{"shape": "circle", "label": `B "quoted"`},
+				{"shape": "box"},
+			},
+			wantEdges: []graph.Edge[float64]{{ID: 0, From: 0, To: 1, Weight: 2.5}, {ID: 1, From: 1, To: 2, Weight: -1}},
+			wantAttr:  []graph.Attributes{{"color": "red", "style": "dashed"}, {"color": "blue", "label": "<b>bold</b>"}},
+		},
+		"numerals": {input: "digraph { 1 -> -2.5 -> .5 }", wantNames: []string{"1", "-2.5", ".5"},
+			wantNodes: []graph.Attributes{{}, {}, {}},
+			wantEdges: []graph.Edge[float64]{{ID: 0, From: 0, To: 1}, {ID: 1, From: 1, To: 2}},
+			wantAttr:  []graph.Attributes{{}, {}},
+		},
+		"quoted keywords": {input: `digraph { "node" [shape=box]; "edge" -> "Graph" -> "subgraph"; NODE [color=red] "strict" }`,
+			wantNames: []string{"node", "edge", "Graph", "subgraph", "strict"},
+			wantNodes: []graph.Attributes{{"shape": "box"}, {}, {}, {}, {"color": "red"}},
+			wantEdges: []graph.Edge[float64]{{ID: 0, From: 1, To: 2}, {ID: 1, From: 2, To: 3}},
+			wantAttr:  []graph.Attributes{{}, {}},
+		},
+
+		// Errors.
+		"not a graph":         {input: "tree { a -> b }", wantErr: true},
+		"missing brace":       {input: "digraph { a -> b", wantErr: true},
+		"trailing tokens":     {input: "digraph { a -> b } c", wantErr: true},
+		"subgraph":            {input: "digraph { subgraph { a } }", wantErr: true},
+		"port":                {input: "digraph { a:n -> b }", wantErr: true},
+		"unterminated string": {input: `digraph { "a -> b }`, wantErr: true},
+		"unterminated list":   {input: "digraph { a [label=x }", wantErr: true},
+		"invalid weight":      {input: "digraph { a -> b [weight=heavy] }", wantErr: true},
+		"invalid character":   {input: "digraph { a -> b ! }", wantErr: true},
+		"dangling edge":       {input: "digraph { a -> }", wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			names := []string{}
+			nodes := []graph.Attributes{}
+			attr := []graph.Attributes{}
+			g, err := graph.ReadDOT(strings.NewReader(tc.input), graph.Decoder[float64]{
+				Node: func(n int, name string, a graph.Attributes) error {
+					require.Equal(t, len(names), n, "Nodes decoded out of order")
+					names = append(names, name)
+					nodes = append(nodes, a)
+					return nil
+				},
+				Edge: func(e graph.Edge[float64], a graph.Attributes) error {
+					require.Equal(t, len(attr), e.ID, "Edges decoded out of order")
+					attr = append(attr, a)
+					return nil
+				},
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, len(tc.wantNames), g.Len())
+			require.Equal(t, tc.wantNames, names)
+			require.Equal(t, tc.wantNodes, nodes)
+			require.Equal(t, len(tc.wantEdges), g.Size())
+			require.Equal(t, tc.wantAttr, attr)
+			for _, e := range tc.wantEdges {
+				require.Equal(t, e, g.Edge(e.ID))
+			}
+		})
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	t.Parallel()
+
+	g := graph.New[int](3)
+	g.AddEdge(0, 1, 5)
+	g.AddEdge(1, 2, -3)
+
+	var b strings.Builder
+	err := graph.WriteDOT(&b, g, graph.Encoder[int]{
+		Node: func(n int) graph.Attributes {
+			if n == 1 {
+				return graph.Attributes{"label": `say "hi"`, "shape": "box"}
+			}
+			return nil
+		},
+		Edge: func(e graph.Edge[int]) graph.Attributes {
+			return graph.Attributes{"weight": "ignored", "color": "red"}
+		},
+	})
+	require.NoError(t, err)
+
+	want := `digraph {
+	0;
+	1 [label="say \"hi\"", shape=box];
+	2;
+	0 -> 1 [color=red, weight=5];
+	1 -> 2 [color=red, weight=-3];
+}
+`
+	require.Equal(t, want, b.String())
+}
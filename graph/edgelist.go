@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// WriteEdgeList writes the edges of the graph as comma-separated values, in order
+// of ID. The first row is a header with the columns "from", "to" and "weight",
+// followed by one column per edge attribute provided by the encoder. Edges that
+// lack an attribute leave its cell empty, so attributes with empty values cannot
+// be told apart from missing ones, and are lost.
+//
+// Node attributes are not written, and neither are nodes without edges. Hence,
+// node attributes and isolated nodes with the highest IDs are lost.
+func WriteEdgeList[W utils.Number](w io.Writer, g *Graph[W], enc Encoder[W]) error {
+	attr := make([]Attributes, g.Size())
+	keys := Attributes{}
+	for _, e := range g.edges {
+		attr[e.ID] = enc.edge(e)
+		for k := range attr[e.ID] {
+			keys[k] = ""
+		}
+	}
+	columns := sortedKeys(keys)
+
+	out := csv.NewWriter(w)
+	if err := out.Write(append([]string{"from", "to", weightKey}, columns...)); err != nil {
+		return err
+	}
+
+	row := make([]string, 3+len(columns))
+	for _, e := range g.edges {
+		row[0] = strconv.Itoa(e.From)
+		row[1] = strconv.Itoa(e.To)
+		row[2] = formatWeight(e.Weight)
+		for i, k := range columns {
+			row[3+i] = attr[e.ID][k]
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
+
+// ReadEdgeList reads a graph from a list of edges written as comma-separated
+// values. The first row must be a header containing the columns "from" and "to",
+// with the IDs of the nodes each edge joins. The graph has as many nodes as the
+// highest ID plus one.
+//
+// If there is a "weight" column, edge weights are read from it. Otherwise, they
+// default to zero. Every other column is passed to the decoder as an attribute,
+// except for empty cells, which are treated as missing attributes. The node
+// decoder is never called.
+func ReadEdgeList[W utils.Number](r io.Reader, dec Decoder[W]) (*Graph[W], error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse edge list: %v", err)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("could not parse edge list: missing header")
+	}
+
+	header := rows[0]
+	from, to := -1, -1
+	for i, col := range header {
+		switch col {
+		case "from":
+			from = i
+		case "to":
+			to = i
+		}
+	}
+	if from < 0 || to < 0 {
+		return nil, errors.New(`could not parse edge list: header must contain columns "from" and "to"`)
+	}
+
+	edges := make([][2]int, 0, len(rows)-1)
+	nodes := 0
+	for i, row := range rows[1:] {
+		var e [2]int
+		for j, col := range []int{from, to} {
+			if e[j], err = strconv.Atoi(row[col]); err != nil || e[j] < 0 {
+				return nil, fmt.Errorf("could not parse edge list: row %d: invalid node %q", i+1, row[col])
+			}
+			nodes = utils.Max(nodes, e[j]+1)
+		}
+		edges = append(edges, e)
+	}
+
+	g := New[W](nodes)
+	for i, e := range edges {
+		attr := Attributes{}
+		for j, v := range rows[i+1] {
+			if j != from && j != to && v != "" {
+				attr[header[j]] = v
+			}
+		}
+		if err := dec.edge(g, e[0], e[1], attr); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
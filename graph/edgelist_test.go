@@ -0,0 +1,66 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEdgeList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     string
+		wantNodes int
+		wantEdges []graph.Edge[int]
+		wantAttr  []graph.Attributes
+		wantErr   bool
+	}{
+		"header only":     {input: "from,to\n", wantNodes: 0, wantAttr: []graph.Attributes{}},
+		"without weights": {input: "to,from\n1,0\n", wantNodes: 2, wantEdges: []graph.Edge[int]{{ID: 0, From: 0, To: 1}}, wantAttr: []graph.Attributes{{}}},
+		"isolated nodes":  {input: "from,to\n5,2\n", wantNodes: 6, wantEdges: []graph.Edge[int]{{ID: 0, From: 5, To: 2}}, wantAttr: []graph.Attributes{{}}},
+		"attributes": {
+			input:     "from,to,weight,color,label\n0,1,5,red,\n1,2,,,\"hello, world\"\n",
+			wantNodes: 3,
+			wantEdges: []graph.Edge[int]{{ID: 0, From: 0, To: 1, Weight: 5}, {ID: 1, From: 1, To: 2}},
+			wantAttr:  []graph.Attributes{{"color": "red"}, {"label": "hello, world"}},
+		},
+
+		// Errors.
+		"empty":           {input: "", wantErr: true},
+		"missing columns": {input: "from,weight\n0,1\n", wantErr: true},
+		"negative node":   {input: "from,to\n0,-1\n", wantErr: true},
+		"invalid node":    {input: "from,to\n0,a\n", wantErr: true},
+		"invalid weight":  {input: "from,to,weight\n0,1,1.5\n", wantErr: true},
+		"ragged":          {input: "from,to,weight\n0,1\n", wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attr := []graph.Attributes{}
+			g, err := graph.ReadEdgeList(strings.NewReader(tc.input), graph.Decoder[int]{
+				Edge: func(e graph.Edge[int], a graph.Attributes) error {
+					attr = append(attr, a)
+					return nil
+				},
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantNodes, g.Len())
+			require.Equal(t, len(tc.wantEdges), g.Size())
+			require.Equal(t, tc.wantAttr, attr)
+			for _, e := range tc.wantEdges {
+				require.Equal(t, e, g.Edge(e.ID))
+			}
+		})
+	}
+}
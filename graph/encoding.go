@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Attributes are the key-value pairs attached to a node or an edge when it is
+// written to, or read from, a file. The key "weight" is reserved for the edge
+// weight, and it is never passed to encoders or decoders.
+type Attributes map[string]string
+
+// Encoder converts the data attached to nodes and edges into attributes, so
+// that it can be written alongside the graph. Either function can be nil, in
+// which case no attributes are written.
+type Encoder[W utils.Number] struct {
+	Node func(n int) Attributes
+	Edge func(e Edge[W]) Attributes
+}
+
+// Decoder receives the attributes of every node and edge read from a file, so
+// that the data attached to them can be rebuilt. The name of a node is the
+// identifier it had in the file. Either function can be nil, in which case the
+// attributes are discarded.
+type Decoder[W utils.Number] struct {
+	Node func(n int, name string, attr Attributes) error
+	Edge func(e Edge[W], attr Attributes) error
+}
+
+const weightKey = "weight"
+
+func (enc Encoder[W]) node(n int) Attributes {
+	if enc.Node == nil {
+		return Attributes{}
+	}
+	return withoutWeight(enc.Node(n))
+}
+
+func (enc Encoder[W]) edge(e Edge[W]) Attributes {
+	if enc.Edge == nil {
+		return Attributes{}
+	}
+	return withoutWeight(enc.Edge(e))
+}
+
+func (dec Decoder[W]) node(n int, name string, attr Attributes) error {
+	if dec.Node == nil {
+		return nil
+	}
+	if err := dec.Node(n, name, attr); err != nil {
+		return fmt.Errorf("could not decode node %q: %v", name, err)
+	}
+	return nil
+}
+
+// edge builds an edge from its attributes, and decodes the rest of them.
+func (dec Decoder[W]) edge(g *Graph[W], from, to int, attr Attributes) error {
+	var weight W
+	if s, ok := attr[weightKey]; ok {
+		w, err := parseWeight[W](s)
+		if err != nil {
+			return err
+		}
+		weight = w
+		attr = withoutWeight(attr)
+	}
+
+	id := g.AddEdge(from, to, weight)
+	if dec.Edge == nil {
+		return nil
+	}
+	if err := dec.Edge(g.Edge(id), attr); err != nil {
+		return fmt.Errorf("could not decode edge %d: %v", id, err)
+	}
+	return nil
+}
+
+// withoutWeight returns a copy of the attributes without the weight.
+func withoutWeight(attr Attributes) Attributes {
+	out := make(Attributes, len(attr))
+	for k, v := range attr {
+		if k != weightKey {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// sortedKeys returns the keys of the attributes in lexicographical order.
+func sortedKeys(attr Attributes) []string {
+	keys := make([]string, 0, len(attr))
+	for k := range attr {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatWeight writes a weight with as many digits as needed to parse it back exactly.
+func formatWeight[W utils.Number](w W) string {
+	return fmt.Sprint(w)
+}
+
+func parseWeight[W utils.Number](s string) (w W, err error) {
+	r := strings.NewReader(strings.TrimSpace(s))
+	if _, err := fmt.Fscan(r, &w); err != nil {
+		return w, fmt.Errorf("could not parse weight %q: %v", s, err)
+	}
+	if r.Len() != 0 {
+		return w, fmt.Errorf("could not parse weight %q: trailing characters", s)
+	}
+	return w, nil
+}
+
+// errWriter wraps a writer so that only the first error needs to be checked.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...any) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
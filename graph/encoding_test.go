@@ -0,0 +1,172 @@
+package graph_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type format[W utils.Number] struct {
+	write func(io.Writer, *graph.Graph[W], graph.Encoder[W]) error
+	read  func(io.Reader, graph.Decoder[W]) (*graph.Graph[W], error)
+
+	nodeAttributes  bool // Whether node attributes are preserved.
+	emptyAttributes bool // Whether attributes with empty values are preserved.
+}
+
+func formats[W utils.Number]() map[string]format[W] {
+	return map[string]format[W]{
+		"DOT":      {write: graph.WriteDOT[W], read: graph.ReadDOT[W], nodeAttributes: true, emptyAttributes: true},
+		"GraphML":  {write: graph.WriteGraphML[W], read: graph.ReadGraphML[W], nodeAttributes: true, emptyAttributes: true},
+		"EdgeList": {write: graph.WriteEdgeList[W], read: graph.ReadEdgeList[W], nodeAttributes: false, emptyAttributes: false},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testRoundTrip[int])
+	t.Run("int64", testRoundTrip[int64])
+	t.Run("uint8", testRoundTrip[uint8])
+	t.Run("float32", testRoundTrip[float32])
+	t.Run("float64", testRoundTrip[float64])
+}
+
+func testRoundTrip[W utils.Number](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	weights := []W{0, 1, 2, 100}
+	var w W = 1
+	if w/2 != 0 {
+		// Floating point: ensure that no precision is lost.
+		for _, f := range []float64{0.1, -2.5, 1.0 / 3.0, math.MaxFloat32, math.SmallestNonzeroFloat32} {
+			weights = append(weights, W(f))
+		}
+	}
+
+	labels := []string{
+		"", "plain", "with spaces", `"quoted"`, `back\slash`, `trailing\`, "new\nline", "comma, separated",
+		"semi;colon", "<html>", "ünïcödé", "-5", "1.5", "node", "{braces}", "[brackets]", "a=b", "tab\there", "# hash",
+	}
+
+	// One self-loop per label, so that every label is used by some edge.
+	loops := make([][2]int, len(labels))
+
+	testCases := map[string]struct {
+		nodes int
+		edges [][2]int
+	}{
+		"empty":        {nodes: 0},
+		"single node":  {nodes: 1, edges: [][2]int{{0, 0}}},
+		"chain":        {nodes: 4, edges: [][2]int{{0, 1}, {1, 2}, {2, 3}}},
+		"parallel":     {nodes: 2, edges: [][2]int{{0, 1}, {0, 1}, {1, 0}}},
+		"many weights": {nodes: 5, edges: [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}, {0, 2}, {1, 3}, {2, 4}, {3, 0}, {4, 1}}},
+		"backwards":    {nodes: 3, edges: [][2]int{{2, 1}, {1, 0}, {2, 0}}},
+		"every label":  {nodes: 1, edges: loops},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		for formatName, f := range formats[W]() {
+			f := f
+			t.Run(name+"/"+formatName, func(t *testing.T) {
+				t.Parallel()
+
+				g := graph.New[W](tc.nodes)
+				nodeData := make([]string, tc.nodes)
+				for n := range nodeData {
+					nodeData[n] = labels[n%len(labels)]
+				}
+				edgeData := make([]string, len(tc.edges))
+				for i, e := range tc.edges {
+					g.AddEdge(e[0], e[1], weights[i%len(weights)])
+					edgeData[i] = labels[(i+3)%len(labels)]
+				}
+
+				enc := graph.Encoder[W]{
+					Node: func(n int) graph.Attributes {
+						return graph.Attributes{"label": nodeData[n], "index": fmt.Sprint(n)}
+					},
+					Edge: func(e graph.Edge[W]) graph.Attributes {
+						attr := graph.Attributes{"label": edgeData[e.ID]}
+						if e.ID%2 == 0 {
+							attr["even"] = "yes"
+						}
+						return attr
+					},
+				}
+
+				var buff bytes.Buffer
+				require.NoError(t, f.write(&buff, g, enc))
+
+				gotNodeData := make([]string, tc.nodes)
+				gotEdgeData := make([]string, len(tc.edges))
+				dec := graph.Decoder[W]{
+					Node: func(n int, name string, attr graph.Attributes) error {
+						require.Equal(t, fmt.Sprint(n), attr["index"], "Node was read in the wrong order")
+						label, ok := attr["label"]
+						require.Equal(t, f.emptyAttributes || nodeData[n] != "", ok, "Missing or unexpected label")
+						gotNodeData[n] = label
+						return nil
+					},
+					Edge: func(e graph.Edge[W], attr graph.Attributes) error {
+						require.NotContains(t, attr, "weight", "Weight should not be passed to the decoder")
+						_, even := attr["even"]
+						require.Equal(t, e.ID%2 == 0, even, "Missing or unexpected attribute")
+						label, ok := attr["label"]
+						require.Equal(t, f.emptyAttributes || edgeData[e.ID] != "", ok, "Missing or unexpected label")
+						gotEdgeData[e.ID] = label
+						return nil
+					},
+				}
+
+				got, err := f.read(&buff, dec)
+				require.NoError(t, err, "Could not read output:\n%s", buff.String())
+
+				require.Equal(t, g.Edges(), got.Edges())
+				require.Equal(t, edgeData, gotEdgeData)
+				if f.nodeAttributes {
+					require.Equal(t, g.Len(), got.Len())
+					require.Equal(t, nodeData, gotNodeData)
+				}
+			})
+		}
+	}
+}
+
+func TestDecoderError(t *testing.T) {
+	t.Parallel()
+
+	g := graph.New[int](2)
+	g.AddEdge(0, 1, 5)
+
+	for name, f := range formats[int]() {
+		f := f
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buff bytes.Buffer
+			require.NoError(t, f.write(&buff, g, graph.Encoder[int]{}))
+			data := buff.Bytes()
+
+			wantErr := fmt.Errorf("mock error")
+			_, err := f.read(bytes.NewReader(data), graph.Decoder[int]{
+				Edge: func(graph.Edge[int], graph.Attributes) error { return wantErr },
+			})
+			require.Error(t, err, "Decoder error should be propagated")
+
+			if !f.nodeAttributes {
+				return
+			}
+			_, err = f.read(bytes.NewReader(data), graph.Decoder[int]{
+				Node: func(int, string, graph.Attributes) error { return wantErr },
+			})
+			require.Error(t, err, "Decoder error should be propagated")
+		})
+	}
+}
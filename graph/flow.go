@@ -0,0 +1,262 @@
+package graph
+
+import (
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Flow is the result of a network flow computation, where edge
+// weights are interpreted as capacities.
+type Flow[W utils.Number] struct {
+	Value W   // Total flow going from the source to the sink.
+	Edges []W // Flow through each edge, indexed by edge ID.
+}
+
+// MaxFlow computes the maximum flow that can be sent from the source
+// to the sink via Dinic's algorithm. Edge weights are interpreted as
+// capacities, and must not be negative.
+//
+// Complexity is O(|V|²·|E|).
+func MaxFlow[W utils.Number](g *Graph[W], source, sink int) Flow[W] {
+	r := newResidual(g)
+	value := r.dinic(source, sink)
+	return r.flow(value)
+}
+
+// MinCut finds the edges with minimum total capacity that disconnect the
+// sink from the source once removed. Edge weights are interpreted as
+// capacities, and must not be negative.
+//
+// It returns the edges in the cut, sorted by ID, and their total
+// capacity, which equals the maximum flow.
+//
+// Complexity is O(|V|²·|E|).
+func MinCut[W utils.Number](g *Graph[W], source, sink int) (cut []Edge[W], capacity W) {
+	r := newResidual(g)
+	capacity = r.dinic(source, sink)
+
+	level := make([]int, g.Len())
+	r.bfs(source, level)
+
+	cut = []Edge[W]{}
+	for _, e := range g.edges {
+		if e.Weight > 0 && level[e.From] >= 0 && level[e.To] < 0 {
+			cut = append(cut, e)
+		}
+	}
+
+	return cut, capacity
+}
+
+// MinCostMaxFlow computes the maximum flow that can be sent from the
+// source to the sink, such that its total cost is minimal. Edge weights
+// are interpreted as capacities, and must not be negative. The cost of
+// sending one unit of flow through an edge is cost[edge.ID]. The graph
+// must not contain cycles with negative cost.
+//
+// It returns the flow and its total cost.
+//
+// Complexity is O(F·|V|·|E|), where F is the value of the maximum flow.
+func MinCostMaxFlow[W utils.Number](g *Graph[W], cost []W, source, sink int) (Flow[W], W) {
+	if len(cost) != g.Size() {
+		panic("there must be exactly one cost per edge")
+	}
+
+	r := newResidual(g)
+	var value, total W
+	if source == sink {
+		return r.flow(value), total
+	}
+
+	n := g.Len()
+	dist := make([]W, n)
+	reached := make([]bool, n)
+	queued := make([]bool, n)
+	prev := make([]int, n)
+	arcCost := func(a int) W {
+		if a%2 == 0 {
+			return cost[a/2]
+		}
+		return -cost[a/2]
+	}
+
+	for {
+		// Bellman-Ford (queue-based) to find the cheapest augmenting path.
+		for i := range reached {
+			reached[i] = false
+		}
+		reached[source] = true
+		dist[source] = 0
+		queue := []int{source}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			queued[u] = false
+			for _, a := range r.arcs[u] {
+				if r.cap[a] <= 0 {
+					continue
+				}
+				v, d := r.to[a], dist[u]+arcCost(a)
+				if reached[v] && d >= dist[v] {
+					continue
+				}
+				reached[v] = true
+				dist[v] = d
+				prev[v] = a
+				if !queued[v] {
+					queued[v] = true
+					queue = append(queue, v)
+				}
+			}
+		}
+
+		if !reached[sink] {
+			break
+		}
+
+		bottleneck := r.cap[prev[sink]]
+		for v := sink; v != source; v = r.tail(prev[v]) {
+			bottleneck = utils.Min(bottleneck, r.cap[prev[v]])
+		}
+		for v := sink; v != source; v = r.tail(prev[v]) {
+			r.push(prev[v], bottleneck)
+		}
+
+		value += bottleneck
+		total += bottleneck * dist[sink]
+	}
+
+	return r.flow(value), total
+}
+
+// residual is the residual network of a graph. Edge i is represented by
+// two arcs: arc 2i, with the remaining capacity, and arc 2i+1, with the
+// flow already sent through the edge.
+type residual[W utils.Number] struct {
+	to   []int   // Node each arc points to.
+	cap  []W     // Residual capacity of each arc.
+	arcs [][]int // Arcs leaving each node.
+}
+
+func newResidual[W utils.Number](g *Graph[W]) *residual[W] {
+	r := &residual[W]{
+		to:   make([]int, 2*g.Size()),
+		cap:  make([]W, 2*g.Size()),
+		arcs: make([][]int, g.Len()),
+	}
+
+	for _, e := range g.edges {
+		fwd, bwd := 2*e.ID, 2*e.ID+1
+		r.to[fwd], r.cap[fwd] = e.To, e.Weight
+		r.to[bwd] = e.From
+		r.arcs[e.From] = append(r.arcs[e.From], fwd)
+		r.arcs[e.To] = append(r.arcs[e.To], bwd)
+	}
+
+	return r
+}
+
+// tail returns the node arc a leaves from.
+func (r *residual[W]) tail(a int) int {
+	return r.to[a^1]
+}
+
+// push sends flow f through arc a.
+func (r *residual[W]) push(a int, f W) {
+	r.cap[a] -= f
+	r.cap[a^1] += f
+}
+
+// flow returns the flow through every edge.
+func (r *residual[W]) flow(value W) Flow[W] {
+	f := Flow[W]{
+		Value: value,
+		Edges: make([]W, len(r.cap)/2),
+	}
+	for i := range f.Edges {
+		f.Edges[i] = r.cap[2*i+1]
+	}
+	return f
+}
+
+// bfs computes the distance from the source to every node in the residual
+// network. Unreachable nodes are assigned a distance of -1.
+func (r *residual[W]) bfs(source int, level []int) {
+	for i := range level {
+		level[i] = -1
+	}
+	level[source] = 0
+
+	queue := []int{source}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range r.arcs[u] {
+			if v := r.to[a]; r.cap[a] > 0 && level[v] < 0 {
+				level[v] = level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+}
+
+// dinic saturates the residual network and returns the total flow sent.
+func (r *residual[W]) dinic(source, sink int) (value W) {
+	if source == sink {
+		return value
+	}
+
+	level := make([]int, len(r.arcs))
+	next := make([]int, len(r.arcs))
+	for r.bfs(source, level); level[sink] >= 0; r.bfs(source, level) {
+		for i := range next {
+			next[i] = 0
+		}
+		for {
+			f, ok := r.augment(source, sink, level, next)
+			if !ok {
+				break
+			}
+			value += f
+		}
+	}
+
+	return value
+}
+
+// augment finds a path from the source to the sink in the level graph, and
+// sends as much flow as possible through it. It returns false if there is
+// no such path.
+func (r *residual[W]) augment(source, sink int, level, next []int) (W, bool) {
+	var path []int
+	for u := source; u != sink; {
+		if next[u] == len(r.arcs[u]) {
+			// Dead end: prune it and backtrack.
+			if u == source {
+				return 0, false
+			}
+			level[u] = -1
+			u = r.tail(path[len(path)-1])
+			path = path[:len(path)-1]
+			next[u]++
+			continue
+		}
+
+		a := r.arcs[u][next[u]]
+		if v := r.to[a]; r.cap[a] > 0 && level[v] == level[u]+1 {
+			path = append(path, a)
+			u = v
+			continue
+		}
+		next[u]++
+	}
+
+	bottleneck := r.cap[path[0]]
+	for _, a := range path[1:] {
+		bottleneck = utils.Min(bottleneck, r.cap[a])
+	}
+	for _, a := range path {
+		r.push(a, bottleneck)
+	}
+
+	return bottleneck, true
+}
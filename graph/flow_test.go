@@ -0,0 +1,158 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxFlow(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testMaxFlow[int])
+	t.Run("int64", testMaxFlow[int64])
+	t.Run("uint", testMaxFlow[uint])
+	t.Run("float64", testMaxFlow[float64])
+}
+
+func TestMinCostMaxFlow(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testMinCostMaxFlow[int])
+	t.Run("int64", testMinCostMaxFlow[int64])
+	t.Run("float64", testMinCostMaxFlow[float64])
+}
+
+// textbook is the flow network in Introduction to Algorithms (Cormen et al.), figure 26.1.
+var textbook = [][3]int{
+	{0, 1, 16}, {0, 2, 13}, {1, 3, 12}, {2, 1, 4}, {2, 4, 14},
+	{3, 2, 9}, {3, 5, 20}, {4, 3, 7}, {4, 5, 4},
+}
+
+func testMaxFlow[W utils.Number](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nodes        int
+		edges        [][3]int
+		source, sink int
+		want         W
+		wantCut      []int
+	}{
+		"source is sink": {nodes: 2, edges: [][3]int{{0, 1, 5}}, source: 0, sink: 0, want: 0, wantCut: []int{}},
+		"disconnected":   {nodes: 3, edges: [][3]int{{0, 1, 5}}, source: 0, sink: 2, want: 0, wantCut: []int{}},
+		"wrong way":      {nodes: 2, edges: [][3]int{{1, 0, 5}}, source: 0, sink: 1, want: 0, wantCut: []int{}},
+		"single":         {nodes: 2, edges: [][3]int{{0, 1, 5}}, source: 0, sink: 1, want: 5, wantCut: []int{0}},
+		"series":         {nodes: 3, edges: [][3]int{{0, 1, 5}, {1, 2, 3}}, source: 0, sink: 2, want: 3, wantCut: []int{1}},
+		"parallel":       {nodes: 2, edges: [][3]int{{0, 1, 5}, {0, 1, 3}}, source: 0, sink: 1, want: 8, wantCut: []int{0, 1}},
+		"textbook":       {nodes: 6, edges: textbook, source: 0, sink: 5, want: 23, wantCut: []int{2, 7, 8}},
+		"backwards flow": {nodes: 4, source: 0, sink: 3, want: 2, wantCut: []int{0, 1}, edges: [][3]int{
+			{0, 1, 1}, {0, 2, 1}, {1, 2, 1}, {1, 3, 1}, {2, 3, 1},
+		}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.New[W](tc.nodes)
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1], W(e[2]))
+			}
+
+			flow := graph.MaxFlow(g, tc.source, tc.sink)
+			require.Equal(t, tc.want, flow.Value)
+			requireValidFlow(t, g, flow, tc.source, tc.sink)
+
+			cut, capacity := graph.MinCut(g, tc.source, tc.sink)
+			require.Equal(t, tc.want, capacity)
+
+			ids := make([]int, 0, len(cut))
+			var sum W
+			for _, e := range cut {
+				ids = append(ids, e.ID)
+				sum += e.Weight
+			}
+			require.Equal(t, tc.wantCut, ids)
+			require.Equal(t, tc.want, sum, "Capacity of the cut does not match the edges in it")
+		})
+	}
+}
+
+func testMinCostMaxFlow[W utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nodes        int
+		edges        [][4]int // from, to, capacity, cost
+		source, sink int
+		want         W
+		wantCost     W
+		wantFlow     []W
+	}{
+		"source is sink": {nodes: 2, edges: [][4]int{{0, 1, 5, 1}}, source: 0, sink: 0, want: 0, wantCost: 0, wantFlow: []W{0}},
+		"disconnected":   {nodes: 3, edges: [][4]int{{0, 1, 5, 1}}, source: 0, sink: 2, want: 0, wantCost: 0, wantFlow: []W{0}},
+		"single":         {nodes: 2, edges: [][4]int{{0, 1, 5, 2}}, source: 0, sink: 1, want: 5, wantCost: 10, wantFlow: []W{5}},
+		"cheapest path": {nodes: 4, source: 0, sink: 3, want: 1, wantCost: 3, wantFlow: []W{1, 1, 0, 1}, edges: [][4]int{
+			{0, 1, 1, 1}, {1, 2, 1, 1}, {1, 3, 1, 5}, {2, 3, 1, 1},
+		}},
+		"forced paths": {nodes: 4, source: 0, sink: 3, want: 4, wantCost: 19, wantFlow: []W{2, 2, 1, 1, 3}, edges: [][4]int{
+			{0, 1, 2, 1}, {0, 2, 2, 4}, {1, 2, 1, 1}, {1, 3, 1, 5}, {2, 3, 3, 1},
+		}},
+		"rerouting": {nodes: 4, source: 0, sink: 3, want: 2, wantCost: 6, wantFlow: []W{1, 1, 0, 1, 1}, edges: [][4]int{
+			{0, 1, 1, 1}, {0, 2, 1, 2}, {1, 2, 1, 0}, {1, 3, 1, 2}, {2, 3, 1, 1},
+		}},
+		"negative cost": {nodes: 3, source: 0, sink: 2, want: 2, wantCost: 0, wantFlow: []W{2, 1, 1}, edges: [][4]int{
+			{0, 1, 2, 1}, {1, 2, 1, -3}, {1, 2, 5, 1},
+		}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.New[W](tc.nodes)
+			cost := make([]W, 0, len(tc.edges))
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1], W(e[2]))
+				cost = append(cost, W(e[3]))
+			}
+
+			flow, totalCost := graph.MinCostMaxFlow(g, cost, tc.source, tc.sink)
+			require.Equal(t, tc.want, flow.Value)
+			require.Equal(t, tc.wantCost, totalCost)
+			require.Equal(t, tc.wantFlow, flow.Edges)
+			requireValidFlow(t, g, flow, tc.source, tc.sink)
+
+			require.Panics(t, func() { graph.MinCostMaxFlow(g, cost[1:], tc.source, tc.sink) }, "Unexpected success with missing costs")
+		})
+	}
+}
+
+// requireValidFlow checks that the flow respects capacities and is conserved at every node.
+func requireValidFlow[W utils.Number](t *testing.T, g *graph.Graph[W], flow graph.Flow[W], source, sink int) {
+	t.Helper()
+
+	require.Len(t, flow.Edges, g.Size())
+
+	balance := make([]W, g.Len())
+	for _, e := range g.Edges() {
+		f := flow.Edges[e.ID]
+		require.GreaterOrEqual(t, f, W(0), "Negative flow through edge %d", e.ID)
+		require.LessOrEqual(t, f, e.Weight, "Flow exceeds capacity of edge %d", e.ID)
+		balance[e.To] += f
+		balance[e.From] -= f
+	}
+
+	for n, b := range balance {
+		if n == source || n == sink {
+			continue
+		}
+		require.Equal(t, W(0), b, "Flow is not conserved at node %d", n)
+	}
+	if source != sink {
+		require.Equal(t, flow.Value, balance[sink], "Flow into the sink does not match the flow value")
+	}
+}
@@ -0,0 +1,87 @@
+// Package graph implements graph data structures and algorithms.
+package graph
+
+import (
+	"fmt"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Edge is a weighted edge going from one node to another. Its ID
+// is the order in which it was added to the graph.
+type Edge[W utils.Number] struct {
+	ID       int
+	From, To int
+	Weight   W
+}
+
+// Graph is a directed graph with weighted edges. Nodes are identified by
+// the integers in the range [0, Len()), and edges by the integers in the
+// range [0, Size()).
+//
+// Undirected graphs are represented by adding every edge once, and using
+// algorithms that disregard direction.
+type Graph[W utils.Number] struct {
+	edges []Edge[W]
+	out   [][]int // out[n] contains the IDs of the edges leaving node n.
+}
+
+// New creates a graph with n nodes and no edges.
+func New[W utils.Number](n int) *Graph[W] {
+	return &Graph[W]{
+		out: make([][]int, n),
+	}
+}
+
+// Len is the count of nodes in the graph.
+func (g Graph[W]) Len() int {
+	return len(g.out)
+}
+
+// Size is the count of edges in the graph.
+func (g Graph[W]) Size() int {
+	return len(g.edges)
+}
+
+// AddNode adds a node to the graph and returns its ID.
+func (g *Graph[W]) AddNode() int {
+	g.out = append(g.out, nil)
+	return len(g.out) - 1
+}
+
+// AddEdge adds an edge going from node 'from' to node 'to', and
+// returns its ID.
+func (g *Graph[W]) AddEdge(from, to int, weight W) int {
+	if from < 0 || from >= g.Len() {
+		panic(fmt.Errorf("node %d out of range [0, %d)", from, g.Len()))
+	}
+	if to < 0 || to >= g.Len() {
+		panic(fmt.Errorf("node %d out of range [0, %d)", to, g.Len()))
+	}
+
+	id := len(g.edges)
+	g.edges = append(g.edges, Edge[W]{ID: id, From: from, To: to, Weight: weight})
+	g.out[from] = append(g.out[from], id)
+	return id
+}
+
+// Edge returns the edge with the specified ID.
+func (g Graph[W]) Edge(id int) Edge[W] {
+	return g.edges[id]
+}
+
+// Edges returns a copy of all edges, sorted by ID.
+func (g Graph[W]) Edges() []Edge[W] {
+	e := make([]Edge[W], len(g.edges))
+	copy(e, g.edges)
+	return e
+}
+
+// Out returns all edges leaving node n.
+func (g Graph[W]) Out(n int) []Edge[W] {
+	e := make([]Edge[W], 0, len(g.out[n]))
+	for _, id := range g.out[n] {
+		e = append(e, g.edges[id])
+	}
+	return e
+}
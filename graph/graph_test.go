@@ -0,0 +1,35 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+
+	g := graph.New[int](2)
+	require.Equal(t, 2, g.Len())
+	require.Equal(t, 0, g.Size())
+
+	require.Equal(t, 2, g.AddNode())
+	require.Equal(t, 3, g.Len())
+
+	require.Equal(t, 0, g.AddEdge(0, 1, 5))
+	require.Equal(t, 1, g.AddEdge(0, 2, 7))
+	require.Equal(t, 2, g.AddEdge(2, 0, 1))
+	require.Equal(t, 3, g.Size())
+
+	require.Equal(t, graph.Edge[int]{ID: 1, From: 0, To: 2, Weight: 7}, g.Edge(1))
+	require.Equal(t, []graph.Edge[int]{
+		{ID: 0, From: 0, To: 1, Weight: 5},
+		{ID: 1, From: 0, To: 2, Weight: 7},
+	}, g.Out(0))
+	require.Empty(t, g.Out(1))
+	require.Len(t, g.Edges(), 3)
+
+	require.Panics(t, func() { g.AddEdge(0, 3, 1) }, "Unexpected success adding edge to missing node")
+	require.Panics(t, func() { g.AddEdge(-1, 0, 1) }, "Unexpected success adding edge from missing node")
+}
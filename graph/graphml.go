@@ -0,0 +1,218 @@
+package graph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// WriteGraphML writes the graph in the GraphML format. Nodes are named after their
+// ID, and edges are written in order of ID. The attributes provided by the encoder
+// are attached to every node and edge, alongside the edge weights.
+func WriteGraphML[W utils.Number](w io.Writer, g *Graph[W], enc Encoder[W]) error {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Graph: graphMLGraph{EdgeDefault: "directed"},
+	}
+
+	nodeAttr := make([]Attributes, g.Len())
+	nodeKeys := Attributes{}
+	for n := range nodeAttr {
+		nodeAttr[n] = enc.node(n)
+		for k := range nodeAttr[n] {
+			nodeKeys[k] = ""
+		}
+	}
+
+	edgeAttr := make([]Attributes, g.Size())
+	edgeKeys := Attributes{}
+	for _, e := range g.edges {
+		edgeAttr[e.ID] = enc.edge(e)
+		for k := range edgeAttr[e.ID] {
+			edgeKeys[k] = ""
+		}
+	}
+
+	// Keys are identified by their position: n0, n1, ... for nodes and e0, e1, ... for edges.
+	doc.Keys = append(doc.Keys, graphMLKey{ID: weightKey, For: "edge", Name: weightKey, Type: graphMLType[W]()})
+	for i, k := range sortedKeys(nodeKeys) {
+		nodeKeys[k] = fmt.Sprintf("n%d", i)
+		doc.Keys = append(doc.Keys, graphMLKey{ID: nodeKeys[k], For: "node", Name: k, Type: "string"})
+	}
+	for i, k := range sortedKeys(edgeKeys) {
+		edgeKeys[k] = fmt.Sprintf("e%d", i)
+		doc.Keys = append(doc.Keys, graphMLKey{ID: edgeKeys[k], For: "edge", Name: k, Type: "string"})
+	}
+
+	doc.Graph.Nodes = make([]graphMLNode, g.Len())
+	for n, attr := range nodeAttr {
+		doc.Graph.Nodes[n] = graphMLNode{ID: fmt.Sprint(n), Data: graphMLData(attr, nodeKeys)}
+	}
+
+	doc.Graph.Edges = make([]graphMLEdge, g.Size())
+	for _, e := range g.edges {
+		data := append(
+			[]graphMLDatum{{Key: weightKey, Value: formatWeight(e.Weight)}},
+			graphMLData(edgeAttr[e.ID], edgeKeys)...,
+		)
+		doc.Graph.Edges[e.ID] = graphMLEdge{
+			Source: fmt.Sprint(e.From),
+			Target: fmt.Sprint(e.To),
+			Data:   data,
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	x := xml.NewEncoder(w)
+	x.Indent("", "  ")
+	if err := x.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGraphML reads a graph written in the GraphML format. Nodes are numbered in
+// order of appearance, and so are edges. The attributes of every node and edge
+// are passed to the decoder, including default values declared in the keys. Edge
+// weights are read from the "weight" attribute, and default to zero.
+//
+// Only the first graph in the file is read. Nested graphs and hyperedges are
+// not supported.
+func ReadGraphML[W utils.Number](r io.Reader, dec Decoder[W]) (*Graph[W], error) {
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("could not parse GraphML: %v", err)
+	}
+
+	// Map from key ID to attribute name, and default attributes.
+	names := map[string]string{}
+	nodeDefaults, edgeDefaults := Attributes{}, Attributes{}
+	for _, k := range doc.Keys {
+		names[k.ID] = k.Name
+		if k.Default == nil {
+			continue
+		}
+		if k.For == "node" || k.For == "all" {
+			nodeDefaults[k.Name] = *k.Default
+		}
+		if k.For == "edge" || k.For == "all" {
+			edgeDefaults[k.Name] = *k.Default
+		}
+	}
+
+	attributes := func(defaults Attributes, data []graphMLDatum) (Attributes, error) {
+		attr := Attributes{}
+		for k, v := range defaults {
+			attr[k] = v
+		}
+		for _, d := range data {
+			name, ok := names[d.Key]
+			if !ok {
+				return nil, fmt.Errorf("could not parse GraphML: undeclared key %q", d.Key)
+			}
+			attr[name] = d.Value
+		}
+		return attr, nil
+	}
+
+	g := New[W](len(doc.Graph.Nodes))
+	ids := make(map[string]int, len(doc.Graph.Nodes))
+	for n, node := range doc.Graph.Nodes {
+		if _, ok := ids[node.ID]; ok {
+			return nil, fmt.Errorf("could not parse GraphML: duplicate node %q", node.ID)
+		}
+		ids[node.ID] = n
+
+		attr, err := attributes(nodeDefaults, node.Data)
+		if err != nil {
+			return nil, err
+		}
+		if err := dec.node(n, node.ID, attr); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, e := range doc.Graph.Edges {
+		from, ok := ids[e.Source]
+		if !ok {
+			return nil, fmt.Errorf("could not parse GraphML: undeclared node %q", e.Source)
+		}
+		to, ok := ids[e.Target]
+		if !ok {
+			return nil, fmt.Errorf("could not parse GraphML: undeclared node %q", e.Target)
+		}
+
+		attr, err := attributes(edgeDefaults, e.Data)
+		if err != nil {
+			return nil, err
+		}
+		if err := dec.edge(g, from, to, attr); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// graphMLType is the GraphML type used to store weights.
+func graphMLType[W utils.Number]() string {
+	var w W
+	switch reflect.TypeOf(w).Kind() { //nolint: exhaustive // Only floats need special treatment.
+	case reflect.Float32, reflect.Float64:
+		return "double"
+	default:
+		return "long"
+	}
+}
+
+// graphMLData converts attributes into data, sorted by attribute name.
+func graphMLData(attr Attributes, keys Attributes) []graphMLDatum {
+	data := make([]graphMLDatum, 0, len(attr))
+	for _, k := range sortedKeys(attr) {
+		data = append(data, graphMLDatum{Key: keys[k], Value: attr[k]})
+	}
+	return data
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr"`
+	Name    string  `xml:"attr.name,attr"`
+	Type    string  `xml:"attr.type,attr,omitempty"`
+	Default *string `xml:"default,omitempty"`
+}
+
+type graphMLGraph struct {
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string         `xml:"id,attr"`
+	Data []graphMLDatum `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string         `xml:"source,attr"`
+	Target string         `xml:"target,attr"`
+	Data   []graphMLDatum `xml:"data"`
+}
+
+type graphMLDatum struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
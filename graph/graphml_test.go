@@ -0,0 +1,91 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadGraphML(t *testing.T) {
+	t.Parallel()
+
+	const header = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<key id="d0" for="node" attr.name="color" attr.type="string"><default>yellow</default></key>
+	<key id="d1" for="edge" attr.name="weight" attr.type="double"/>
+	<key id="d2" for="all" attr.name="note" attr.type="string"/>
+`
+
+	testCases := map[string]struct {
+		input     string
+		wantNames []string
+		wantNodes []graph.Attributes
+		wantEdges []graph.Edge[float64]
+		wantAttr  []graph.Attributes
+		wantErr   bool
+	}{
+		"empty": {input: header + `<graph edgedefault="directed"/></graphml>`,
+			wantNames: []string{}, wantNodes: []graph.Attributes{}, wantAttr: []graph.Attributes{},
+		},
+		"defaults": {
+			input: header + `<graph id="G" edgedefault="directed">
+				<node id="a"/>
+				<node id="b"><data key="d0">green</data><data key="d2">hello</data></node>
+				<edge source="a" target="b"><data key="d1">1.5</data></edge>
+				<edge source="b" target="a"><data key="d2">back</data></edge>
+			</graph></graphml>`,
+			wantNames: []string{"a", "b"},
+			wantNodes: []graph.Attributes{{"color": "yellow"}, {"color": "green", "note": "hello"}},
+			wantEdges: []graph.Edge[float64]{{ID: 0, From: 0, To: 1, Weight: 1.5}, {ID: 1, From: 1, To: 0}},
+			wantAttr:  []graph.Attributes{{}, {"note": "back"}},
+		},
+
+		// Errors.
+		"malformed":      {input: header + `<graph>`, wantErr: true},
+		"undeclared key": {input: header + `<graph><node id="a"><data key="x">1</data></node></graph></graphml>`, wantErr: true},
+		"duplicate node": {input: header + `<graph><node id="a"/><node id="a"/></graph></graphml>`, wantErr: true},
+		"missing source": {input: header + `<graph><node id="a"/><edge source="b" target="a"/></graph></graphml>`, wantErr: true},
+		"missing target": {input: header + `<graph><node id="a"/><edge source="a" target="b"/></graph></graphml>`, wantErr: true},
+		"invalid weight": {
+			input:   header + `<graph><node id="a"/><edge source="a" target="a"><data key="d1">x</data></edge></graph></graphml>`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			names := []string{}
+			nodes := []graph.Attributes{}
+			attr := []graph.Attributes{}
+			g, err := graph.ReadGraphML(strings.NewReader(tc.input), graph.Decoder[float64]{
+				Node: func(n int, name string, a graph.Attributes) error {
+					names = append(names, name)
+					nodes = append(nodes, a)
+					return nil
+				},
+				Edge: func(e graph.Edge[float64], a graph.Attributes) error {
+					attr = append(attr, a)
+					return nil
+				},
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantNames, names)
+			require.Equal(t, tc.wantNodes, nodes)
+			require.Equal(t, len(tc.wantEdges), g.Size())
+			require.Equal(t, tc.wantAttr, attr)
+			for _, e := range tc.wantEdges {
+				require.Equal(t, e, g.Edge(e.ID))
+			}
+		})
+	}
+}
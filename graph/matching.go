@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// HopcroftKarp finds a maximum-cardinality matching in the bipartite graph
+// g via the Hopcroft-Karp algorithm. Every edge must go from a node in the
+// left partition to a node in the right partition. Edge weights are ignored.
+//
+// It returns the edges in the matching, sorted by ID.
+//
+// Complexity is O(|E|·√|V|).
+func HopcroftKarp[W utils.Number](g *Graph[W]) []Edge[W] {
+	isLeft := make([]bool, g.Len())
+	for n := range isLeft {
+		isLeft[n] = len(g.out[n]) > 0
+	}
+	for _, e := range g.edges {
+		if isLeft[e.To] {
+			panic(fmt.Errorf("graph is not bipartite: node %d has both incoming and outgoing edges", e.To))
+		}
+	}
+
+	matchL := make([]int, g.Len()) // Edge matching each node in the left partition.
+	matchR := make([]int, g.Len()) // Edge matching each node in the right partition.
+	dist := make([]int, g.Len())
+	for n := range matchL {
+		matchL[n], matchR[n] = -1, -1
+	}
+
+	// limit is the layer of the left nodes next to the closest unmatched right
+	// nodes, i.e. where the shortest augmenting paths end.
+	limit := -1
+
+	// bfs layers the graph by alternating path length from the unmatched left
+	// nodes, up to the first layer with an augmenting path.
+	bfs := func() bool {
+		limit = -1
+		var queue []int
+		for u := range dist {
+			dist[u] = -1
+			if isLeft[u] && matchL[u] < 0 {
+				dist[u] = 0
+				queue = append(queue, u)
+			}
+		}
+
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			if limit >= 0 && dist[u] > limit {
+				break // Deeper layers only lead to longer paths.
+			}
+			for _, id := range g.out[u] {
+				m := matchR[g.edges[id].To]
+				if m < 0 {
+					limit = dist[u]
+					continue
+				}
+				if w := g.edges[m].From; dist[w] < 0 {
+					dist[w] = dist[u] + 1
+					queue = append(queue, w)
+				}
+			}
+		}
+		return limit >= 0
+	}
+
+	// dfs finds a shortest augmenting path along the layers and flips it.
+	var dfs func(u int) bool
+	dfs = func(u int) bool {
+		for _, id := range g.out[u] {
+			v := g.edges[id].To
+			if m := matchR[v]; m < 0 {
+				if dist[u] != limit {
+					continue
+				}
+			} else if w := g.edges[m].From; dist[u] >= limit || dist[w] != dist[u]+1 || !dfs(w) {
+				continue
+			}
+			matchL[u] = id
+			matchR[v] = id
+			return true
+		}
+		dist[u] = -1
+		return false
+	}
+
+	for bfs() {
+		for u := range isLeft {
+			if isLeft[u] && matchL[u] < 0 {
+				dfs(u)
+			}
+		}
+	}
+
+	matching := []Edge[W]{}
+	for _, e := range g.edges {
+		if matchL[e.From] == e.ID {
+			matching = append(matching, e)
+		}
+	}
+	return matching
+}
+
+// Hungarian solves the assignment problem via the Hungarian algorithm. Every
+// row i is a worker and every column j is a task, where cost[i][j] is the cost
+// of worker i performing task j. All rows must have the same length.
+//
+// It finds the assignment with minimum total cost, such that every worker is
+// assigned at most one task and every task is assigned to at most one worker.
+// If there are more workers than tasks, some workers will remain idle, and
+// vice versa.
+//
+// It returns the task assigned to every worker (or -1 if it remains idle),
+// and the total cost. Costs must not exceed half the maximum value that can
+// be represented by W.
+//
+// Complexity is O(n²·m), where n=min(rows, columns) and m=max(rows, columns).
+func Hungarian[W utils.Number](cost [][]W) (assignment []int, total W) {
+	rows := len(cost)
+	if rows == 0 {
+		return []int{}, total
+	}
+	cols := len(cost[0])
+	for i := range cost {
+		if len(cost[i]) != cols {
+			panic(fmt.Errorf("row %d has length %d, but row 0 has length %d", i, len(cost[i]), cols))
+		}
+	}
+
+	if rows <= cols {
+		assignment = hungarian(rows, cols, func(i, j int) W { return cost[i][j] })
+	} else {
+		// Transposed, so that there are never more rows than columns.
+		byTask := hungarian(cols, rows, func(i, j int) W { return cost[j][i] })
+		assignment = make([]int, rows)
+		for worker := range assignment {
+			assignment[worker] = -1
+		}
+		for task, worker := range byTask {
+			assignment[worker] = task
+		}
+	}
+
+	for i, j := range assignment {
+		if j >= 0 {
+			total += cost[i][j]
+		}
+	}
+	return assignment, total
+}
+
+// hungarian solves the assignment problem with n rows and m columns, where n<=m.
+// It returns the column assigned to each row.
+//
+// Only reduced costs are ever compared. These are never negative, and never exceed
+// twice the largest cost. Hence, the potentials are allowed to wrap around, which
+// makes it safe for unsigned types.
+func hungarian[W utils.Number](n, m int, cost func(i, j int) W) []int {
+	// Rows and columns are 1-indexed, so that 0 can be used as a sentinel.
+	u := make([]W, n+1)       // Row potentials.
+	v := make([]W, m+1)       // Column potentials.
+	p := make([]int, m+1)     // Row assigned to each column.
+	way := make([]int, m+1)   // Previous column in the augmenting path.
+	minv := make([]W, m+1)    // Smallest reduced cost reaching each column.
+	seen := make([]bool, m+1) // Whether minv has been initialized.
+	used := make([]bool, m+1) // Whether the column is in the alternating tree.
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		for j := range used {
+			used[j], seen[j] = false, false
+		}
+
+		for p[j0] != 0 {
+			used[j0] = true
+			i0 := p[j0]
+			j1 := -1
+			var delta W
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := cost(i0-1, j-1) - u[i0] - v[j]; !seen[j] || cur < minv[j] {
+					minv[j], way[j], seen[j] = cur, j0, true
+				}
+				if j1 < 0 || minv[j] < delta {
+					delta, j1 = minv[j], j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+
+		// Flip the augmenting path.
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			assignment[p[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
@@ -0,0 +1,192 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHopcroftKarp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		left, right int
+		edges       [][2]int
+		want        int
+	}{
+		"empty":        {want: 0},
+		"no edges":     {left: 3, right: 3, want: 0},
+		"single":       {left: 1, right: 1, edges: [][2]int{{0, 0}}, want: 1},
+		"star":         {left: 1, right: 3, edges: [][2]int{{0, 0}, {0, 1}, {0, 2}}, want: 1},
+		"perfect":      {left: 3, right: 3, edges: [][2]int{{0, 0}, {0, 1}, {1, 0}, {2, 1}, {2, 2}}, want: 3},
+		"augmenting":   {left: 2, right: 2, edges: [][2]int{{0, 0}, {0, 1}, {1, 0}}, want: 2},
+		"bottleneck":   {left: 3, right: 3, edges: [][2]int{{0, 0}, {1, 0}, {2, 0}, {2, 1}}, want: 2},
+		"disconnected": {left: 4, right: 4, edges: [][2]int{{0, 0}, {1, 1}, {3, 2}}, want: 3},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.New[int](tc.left + tc.right)
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], tc.left+e[1], 1)
+			}
+
+			matching := graph.HopcroftKarp(g)
+			require.Len(t, matching, tc.want)
+			requireValidMatching(t, g, matching)
+		})
+	}
+
+	t.Run("not bipartite", func(t *testing.T) {
+		t.Parallel()
+		g := graph.New[int](3)
+		g.AddEdge(0, 1, 1)
+		g.AddEdge(1, 2, 1)
+		require.Panics(t, func() { graph.HopcroftKarp(g) }, "Unexpected success with non-bipartite graph")
+	})
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+		rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+		for i := 0; i < 50; i++ {
+			left, right := 1+rng.Intn(20), 1+rng.Intn(20)
+			g := graph.New[int](left + right)
+			for e := rng.Intn(3 * (left + right)); e > 0; e-- {
+				g.AddEdge(rng.Intn(left), left+rng.Intn(right), 1)
+			}
+
+			matching := graph.HopcroftKarp(g)
+			requireValidMatching(t, g, matching)
+
+			// The maximum matching is the maximum flow from a super-source to a super-sink.
+			source, sink := g.AddNode(), g.AddNode()
+			for n := 0; n < left; n++ {
+				g.AddEdge(source, n, 1)
+			}
+			for n := left; n < left+right; n++ {
+				g.AddEdge(n, sink, 1)
+			}
+			require.Equal(t, graph.MaxFlow(g, source, sink).Value, len(matching), "Matching is not maximal")
+		}
+	})
+}
+
+func TestHungarian(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testHungarian[int])
+	t.Run("int32", testHungarian[int32])
+	t.Run("uint8", testHungarian[uint8])
+	t.Run("float64", testHungarian[float64])
+}
+
+func testHungarian[W utils.Number](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cost     [][]W
+		want     []int
+		wantCost W
+	}{
+		"empty":    {cost: [][]W{}, want: []int{}, wantCost: 0},
+		"single":   {cost: [][]W{{7}}, want: []int{0}, wantCost: 7},
+		"diagonal": {cost: [][]W{{1, 9}, {9, 1}}, want: []int{0, 1}, wantCost: 2},
+		"crossed":  {cost: [][]W{{9, 1}, {1, 9}}, want: []int{1, 0}, wantCost: 2},
+		"greedy trap": {cost: [][]W{
+			{1, 2, 30},
+			{2, 40, 50},
+			{30, 50, 60},
+		}, want: []int{1, 0, 2}, wantCost: 64},
+		"more tasks": {cost: [][]W{
+			{8, 4, 7},
+			{5, 2, 3},
+		}, want: []int{1, 2}, wantCost: 7},
+		"more workers": {cost: [][]W{
+			{8, 4},
+			{5, 2},
+			{9, 1},
+		}, want: []int{-1, 0, 1}, wantCost: 6},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, cost := graph.Hungarian(tc.cost)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantCost, cost)
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+		rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+		for i := 0; i < 100; i++ {
+			rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+			cost := algo.Generate2D(rows, cols, func() W { return W(rng.Intn(50)) })
+
+			got, total := graph.Hungarian(cost)
+			require.Len(t, got, rows)
+
+			var sum W
+			assigned := 0
+			for worker, task := range got {
+				if task < 0 {
+					continue
+				}
+				require.Less(t, task, cols)
+				require.Equal(t, -1, algo.Find(got[worker+1:], task, func(a, b int) bool { return a == b }), "Task assigned twice")
+				sum += cost[worker][task]
+				assigned++
+			}
+			require.Equal(t, utils.Min(rows, cols), assigned, "Not every task or worker was assigned")
+			require.Equal(t, sum, total, "Reported cost does not match the assignment")
+			require.Equal(t, bruteForceAssignment(cost, 0, make([]bool, cols), rows-utils.Min(rows, cols)), total, "Assignment is not optimal")
+		}
+	})
+}
+
+// bruteForceAssignment returns the cheapest way to assign workers [worker, len(cost))
+// to unused tasks, allowing for at most 'idle' workers to remain idle.
+func bruteForceAssignment[W utils.Number](cost [][]W, worker int, used []bool, idle int) W {
+	if worker == len(cost) {
+		return 0
+	}
+
+	var best W
+	found := false
+	if idle > 0 {
+		best, found = bruteForceAssignment(cost, worker+1, used, idle-1), true
+	}
+	for task := range used {
+		if used[task] {
+			continue
+		}
+		used[task] = true
+		c := cost[worker][task] + bruteForceAssignment(cost, worker+1, used, idle)
+		used[task] = false
+		if !found || c < best {
+			best, found = c, true
+		}
+	}
+	return best
+}
+
+// requireValidMatching checks that every node is matched at most once.
+func requireValidMatching[W utils.Number](t *testing.T, g *graph.Graph[W], matching []graph.Edge[W]) {
+	t.Helper()
+
+	matched := make([]bool, g.Len())
+	for _, e := range matching {
+		require.Equal(t, g.Edge(e.ID), e, "Edge does not match the one in the graph")
+		require.False(t, matched[e.From], "Node %d is matched twice", e.From)
+		require.False(t, matched[e.To], "Node %d is matched twice", e.To)
+		matched[e.From], matched[e.To] = true, true
+	}
+}
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/dstruct"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Kruskal computes the minimum spanning forest of graph g via Kruskal's
+// algorithm. The graph is treated as undirected. It returns the edges in
+// the forest, sorted by weight. If the graph is connected, the forest is
+// a single tree.
+//
+// Complexity is O(|E|·log(|E|)).
+func Kruskal[W utils.Number](g *Graph[W]) []Edge[W] {
+	edges := g.Edges()
+	algo.Sort(edges, byWeight[W])
+
+	set := dstruct.NewDisjointSet(g.Len())
+	forest := make([]Edge[W], 0, utils.Max(g.Len()-1, 0))
+	for _, e := range edges {
+		if set.Sets() == 1 {
+			break
+		}
+		if set.Union(e.From, e.To) {
+			forest = append(forest, e)
+		}
+	}
+
+	return forest
+}
+
+// Prim computes the minimum spanning forest of graph g via Prim's
+// algorithm. The graph is treated as undirected. It returns the edges
+// in the forest, in the order they were added to it. If the graph is
+// connected, the forest is a single tree.
+//
+// Complexity is O(|E|·log(|E|)).
+func Prim[W utils.Number](g *Graph[W]) []Edge[W] {
+	incident := make([][]int, g.Len())
+	for _, e := range g.edges {
+		incident[e.From] = append(incident[e.From], e.ID)
+		if e.From != e.To {
+			incident[e.To] = append(incident[e.To], e.ID)
+		}
+	}
+
+	visited := make([]bool, g.Len())
+	frontier := dstruct.NewHeap(byWeight[W])
+	visit := func(n int) {
+		visited[n] = true
+		for _, id := range incident[n] {
+			if e := g.edges[id]; !visited[e.From] || !visited[e.To] {
+				frontier.Push(e)
+			}
+		}
+	}
+
+	forest := make([]Edge[W], 0, utils.Max(g.Len()-1, 0))
+	for root := range visited {
+		if visited[root] {
+			continue
+		}
+		visit(root)
+		for frontier.Len() > 0 {
+			e := frontier.Pop()
+			switch {
+			case !visited[e.To]:
+				visit(e.To)
+			case !visited[e.From]:
+				visit(e.From)
+			default:
+				continue
+			}
+			forest = append(forest, e)
+		}
+	}
+
+	return forest
+}
+
+func byWeight[W utils.Number](a, b Edge[W]) bool {
+	return a.Weight < b.Weight
+}
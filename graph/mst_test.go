@@ -0,0 +1,74 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/dstruct"
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMST(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testMST[int])
+	t.Run("int32", testMST[int32])
+	t.Run("uint", testMST[uint])
+	t.Run("float64", testMST[float64])
+}
+
+func testMST[W utils.Number](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nodes      int
+		edges      [][3]int
+		wantWeight W
+		wantSize   int
+	}{
+		"empty":        {nodes: 0, wantWeight: 0, wantSize: 0},
+		"single node":  {nodes: 1, wantWeight: 0, wantSize: 0},
+		"disconnected": {nodes: 4, edges: [][3]int{{0, 1, 5}, {2, 3, 2}}, wantWeight: 7, wantSize: 2},
+		"self loop":    {nodes: 2, edges: [][3]int{{0, 0, 1}, {0, 1, 3}}, wantWeight: 3, wantSize: 1},
+		"parallel":     {nodes: 2, edges: [][3]int{{0, 1, 8}, {1, 0, 3}}, wantWeight: 3, wantSize: 1},
+		"triangle":     {nodes: 3, edges: [][3]int{{0, 1, 1}, {1, 2, 2}, {2, 0, 3}}, wantWeight: 3, wantSize: 2},
+		"textbook": {nodes: 9, wantWeight: 37, wantSize: 8, edges: [][3]int{
+			{0, 1, 4}, {0, 7, 8}, {1, 2, 8}, {1, 7, 11}, {2, 3, 7}, {2, 5, 4}, {2, 8, 2},
+			{3, 4, 9}, {3, 5, 14}, {4, 5, 10}, {5, 6, 2}, {6, 7, 1}, {6, 8, 6}, {7, 8, 7},
+		}},
+	}
+
+	algorithms := map[string]func(*graph.Graph[W]) []graph.Edge[W]{
+		"Kruskal": graph.Kruskal[W],
+		"Prim":    graph.Prim[W],
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		for algName, mst := range algorithms {
+			mst := mst
+			t.Run(name+"/"+algName, func(t *testing.T) {
+				t.Parallel()
+
+				g := graph.New[W](tc.nodes)
+				for _, e := range tc.edges {
+					g.AddEdge(e[0], e[1], W(e[2]))
+				}
+
+				forest := mst(g)
+				require.Len(t, forest, tc.wantSize)
+
+				weight := algo.MapReduce(forest, func(e graph.Edge[W]) W { return e.Weight }, utils.Add[W], 0)
+				require.Equal(t, tc.wantWeight, weight)
+
+				// Every edge must join two different trees.
+				set := dstruct.NewDisjointSet(tc.nodes)
+				for _, e := range forest {
+					require.Equal(t, g.Edge(e.ID), e, "Edge does not match the one in the graph")
+					require.True(t, set.Union(e.From, e.To), "Forest contains a cycle")
+				}
+			})
+		}
+	}
+}
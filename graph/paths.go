@@ -0,0 +1,208 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/EduardGomezEscandell/algo/dstruct"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// ErrNegativeCycle is returned when shortest paths are not defined because
+// the graph contains a cycle with negative total weight.
+var ErrNegativeCycle = errors.New("graph contains a negative cycle")
+
+// Paths contains the shortest paths between every pair of nodes.
+type Paths[W utils.Number] struct {
+	// Dist[i][j] is the length of the shortest path from node i to node j.
+	// It is only meaningful if j is reachable from i.
+	Dist [][]W
+
+	// Next[i][j] is the node that follows node i in the shortest path from
+	// node i to node j, or -1 if j is not reachable from i. Next[i][i] is i.
+	Next [][]int
+}
+
+// Reachable returns true if there is a path from node 'from' to node 'to'.
+func (p Paths[W]) Reachable(from, to int) bool {
+	return p.Next[from][to] >= 0
+}
+
+// Path reconstructs the shortest path from node 'from' to node 'to'. It
+// returns the list of nodes visited, including both ends, or nil if
+// there is no such path.
+func (p Paths[W]) Path(from, to int) []int {
+	if !p.Reachable(from, to) {
+		return nil
+	}
+
+	path := []int{from}
+	for from != to {
+		from = p.Next[from][to]
+		path = append(path, from)
+	}
+	return path
+}
+
+// FloydWarshall computes the shortest paths between every pair of nodes via the
+// Floyd-Warshall algorithm. Rows of the distance matrix are updated in parallel.
+//
+// It returns ErrNegativeCycle if the graph contains a cycle with negative weight.
+//
+// Complexity is O(|V|³).
+func FloydWarshall[W utils.Number](g *Graph[W]) (Paths[W], error) {
+	n := g.Len()
+	p := newPaths[W](n)
+	for _, e := range g.edges {
+		if p.Next[e.From][e.To] < 0 || e.Weight < p.Dist[e.From][e.To] {
+			p.Dist[e.From][e.To] = e.Weight
+			p.Next[e.From][e.To] = e.To
+		}
+	}
+
+	dist := palgo.NewWorkDistribution(n, 3)
+	distK := make([]W, n)
+	nextK := make([]int, n)
+	for k := 0; k < n; k++ {
+		// Row k is read by every worker, so a snapshot is taken to avoid racing
+		// with the worker that owns it.
+		copy(distK, p.Dist[k])
+		copy(nextK, p.Next[k])
+
+		dist.Run(func(w palgo.WorkAlloc) {
+			for i := w.Begin; i < w.End; i++ {
+				if p.Next[i][k] < 0 {
+					continue
+				}
+				dik, nik := p.Dist[i][k], p.Next[i][k]
+				di, ni := p.Dist[i], p.Next[i]
+				for j := 0; j < n; j++ {
+					if nextK[j] < 0 {
+						continue
+					}
+					if d := dik + distK[j]; ni[j] < 0 || d < di[j] {
+						di[j] = d
+						ni[j] = nik
+					}
+				}
+			}
+		})
+	}
+
+	for i := 0; i < n; i++ {
+		if p.Dist[i][i] < 0 {
+			return Paths[W]{}, ErrNegativeCycle
+		}
+	}
+
+	return p, nil
+}
+
+// Johnson computes the shortest paths between every pair of nodes via Johnson's
+// algorithm. It is faster than FloydWarshall for sparse graphs. Searches from
+// every source are run in parallel.
+//
+// It returns ErrNegativeCycle if the graph contains a cycle with negative weight.
+//
+// Complexity is O(|V|·|E|·log|V|).
+func Johnson[W utils.Number](g *Graph[W]) (Paths[W], error) {
+	n := g.Len()
+
+	// Potentials: shortest distance from a virtual node connected to every other
+	// node with zero-weight edges.
+	h := make([]W, n)
+	for iter := 0; ; iter++ {
+		changed := false
+		for _, e := range g.edges {
+			if d := h[e.From] + e.Weight; d < h[e.To] {
+				h[e.To] = d
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		if iter == n {
+			return Paths[W]{}, ErrNegativeCycle
+		}
+	}
+
+	// Reweighted edges are never negative.
+	reweighted := make([]W, g.Size())
+	for _, e := range g.edges {
+		reweighted[e.ID] = e.Weight + h[e.From] - h[e.To]
+	}
+
+	p := newPaths[W](n)
+	palgo.NewWorkDistribution(n, 3).Run(func(w palgo.WorkAlloc) {
+		for s := w.Begin; s < w.End; s++ {
+			g.dijkstra(s, reweighted, p.Dist[s], p.Next[s])
+			for t := range p.Dist[s] {
+				if p.Next[s][t] >= 0 {
+					p.Dist[s][t] += h[t] - h[s]
+				}
+			}
+		}
+	})
+
+	return p, nil
+}
+
+// dijkstra computes the shortest distance from the source to every node, using the
+// specified (non-negative) edge weights. next[t] is set to the first node after the
+// source in the path to node t, or -1 if it is unreachable.
+func (g Graph[W]) dijkstra(source int, weight []W, dist []W, next []int) {
+	for i := range next {
+		next[i] = -1
+	}
+	next[source] = source
+	dist[source] = 0
+
+	done := make([]bool, g.Len())
+	queue := dstruct.NewHeap(func(a, b queued[W]) bool { return a.dist < b.dist })
+	queue.Push(queued[W]{node: source})
+	for queue.Len() > 0 {
+		u := queue.Pop().node
+		if done[u] {
+			continue
+		}
+		done[u] = true
+
+		for _, id := range g.out[u] {
+			e := g.edges[id]
+			d := dist[u] + weight[id]
+			if done[e.To] || (next[e.To] >= 0 && d >= dist[e.To]) {
+				continue
+			}
+			dist[e.To] = d
+			if u == source {
+				next[e.To] = e.To
+			} else {
+				next[e.To] = next[u]
+			}
+			queue.Push(queued[W]{node: e.To, dist: d})
+		}
+	}
+}
+
+// queued is a node waiting to be visited, with its tentative distance.
+type queued[W utils.Number] struct {
+	node int
+	dist W
+}
+
+func newPaths[W utils.Number](n int) Paths[W] {
+	p := Paths[W]{
+		Dist: make([][]W, n),
+		Next: make([][]int, n),
+	}
+	for i := 0; i < n; i++ {
+		p.Dist[i] = make([]W, n)
+		p.Next[i] = make([]int, n)
+		for j := range p.Next[i] {
+			p.Next[i][j] = -1
+		}
+		p.Next[i][i] = i
+	}
+	return p
+}
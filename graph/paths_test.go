@@ -0,0 +1,135 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/graph"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testAllPairsShortestPaths[int])
+	t.Run("int32", testAllPairsShortestPaths[int32])
+	t.Run("float64", testAllPairsShortestPaths[float64])
+}
+
+func testAllPairsShortestPaths[W utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	algorithms := map[string]func(*graph.Graph[W]) (graph.Paths[W], error){
+		"FloydWarshall": graph.FloydWarshall[W],
+		"Johnson":       graph.Johnson[W],
+	}
+
+	testCases := map[string]struct {
+		nodes   int
+		edges   [][3]int
+		wantErr error
+	}{
+		"empty":             {nodes: 0},
+		"single":            {nodes: 1},
+		"disconnected":      {nodes: 3, edges: [][3]int{{0, 1, 4}}},
+		"shortcut":          {nodes: 3, edges: [][3]int{{0, 1, 4}, {1, 2, 4}, {0, 2, 10}}},
+		"parallel":          {nodes: 2, edges: [][3]int{{0, 1, 4}, {0, 1, 2}, {0, 1, 3}}},
+		"positive cycle":    {nodes: 3, edges: [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}}},
+		"negative edge":     {nodes: 3, edges: [][3]int{{0, 1, 4}, {1, 2, -3}, {0, 2, 2}}},
+		"negative cycle":    {nodes: 3, edges: [][3]int{{0, 1, 1}, {1, 2, -3}, {2, 0, 1}}, wantErr: graph.ErrNegativeCycle},
+		"negative loop":     {nodes: 2, edges: [][3]int{{0, 1, 1}, {1, 1, -1}}, wantErr: graph.ErrNegativeCycle},
+		"positive loop":     {nodes: 2, edges: [][3]int{{0, 1, 1}, {1, 1, 1}}},
+		"random sparse":     {nodes: 30, edges: randomEdges(42, 30, 60, -2, 10)},
+		"random dense":      {nodes: 40, edges: randomEdges(7, 40, 800, 0, 100)},
+		"random negative":   {nodes: 40, edges: randomEdges(3, 40, 200, -5, 20)},
+		"random big weight": {nodes: 25, edges: randomEdges(11, 25, 100, 50, 1000)},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		for algName, apsp := range algorithms {
+			apsp := apsp
+			t.Run(name+"/"+algName, func(t *testing.T) {
+				t.Parallel()
+
+				g := graph.New[W](tc.nodes)
+				for _, e := range tc.edges {
+					g.AddEdge(e[0], e[1], W(e[2]))
+				}
+
+				paths, err := apsp(g)
+				if tc.wantErr != nil {
+					require.ErrorIs(t, err, tc.wantErr)
+					return
+				}
+				require.NoError(t, err)
+
+				for s := 0; s < tc.nodes; s++ {
+					dist, reached := bellmanFord(g, s)
+					for d := 0; d < tc.nodes; d++ {
+						require.Equal(t, reached[d], paths.Reachable(s, d), "Wrong reachability from %d to %d", s, d)
+						if !reached[d] {
+							require.Nil(t, paths.Path(s, d))
+							continue
+						}
+						require.Equal(t, dist[d], paths.Dist[s][d], "Wrong distance from %d to %d", s, d)
+						require.Equal(t, dist[d], pathLength(t, g, paths.Path(s, d)), "Path from %d to %d does not have the shortest length", s, d)
+					}
+				}
+			})
+		}
+	}
+}
+
+// randomEdges generates edges between random nodes. Weights are random numbers in the range
+// [0, maxWeight], shifted by random node potentials in the range [minWeight, 0]. Hence, weights
+// may be negative but cycles never are.
+func randomEdges(seed int64, nodes, edges, minWeight, maxWeight int) (out [][3]int) {
+	rng := rand.New(rand.NewSource(seed)) //nolint: gosec // Deterministic tests.
+	potential := make([]int, nodes)
+	for i := range potential {
+		potential[i] = -rng.Intn(utils.Max(1, 1-minWeight))
+	}
+	for i := 0; i < edges; i++ {
+		from, to := rng.Intn(nodes), rng.Intn(nodes)
+		w := rng.Intn(maxWeight+1) + potential[from] - potential[to]
+		out = append(out, [3]int{from, to, w})
+	}
+	return out
+}
+
+// bellmanFord computes the distance from the source to every node in a graph without negative cycles.
+func bellmanFord[W utils.Number](g *graph.Graph[W], source int) (dist []W, reached []bool) {
+	dist = make([]W, g.Len())
+	reached = make([]bool, g.Len())
+	reached[source] = true
+	edges := g.Edges()
+	for i := 0; i < g.Len(); i++ {
+		for _, e := range edges {
+			if reached[e.From] && (!reached[e.To] || dist[e.From]+e.Weight < dist[e.To]) {
+				dist[e.To] = dist[e.From] + e.Weight
+				reached[e.To] = true
+			}
+		}
+	}
+	return dist, reached
+}
+
+// pathLength computes the length of a path, using the cheapest edge between every pair of nodes.
+func pathLength[W utils.Number](t *testing.T, g *graph.Graph[W], path []int) (length W) {
+	t.Helper()
+
+	require.NotEmpty(t, path)
+	for i := 1; i < len(path); i++ {
+		found := false
+		var best W
+		for _, e := range g.Out(path[i-1]) {
+			if e.To == path[i] && (!found || e.Weight < best) {
+				best, found = e.Weight, true
+			}
+		}
+		require.True(t, found, "Path contains missing edge %d->%d", path[i-1], path[i])
+		length += best
+	}
+	return length
+}
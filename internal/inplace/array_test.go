@@ -33,6 +33,14 @@ func TestZipWith(t *testing.T) {
 	t.Run("int64", testZipWith[int64])
 }
 
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testMerge[int])
+	t.Run("int8", testMerge[int8])
+	t.Run("int32", testMerge[int32])
+	t.Run("int64", testMerge[int64])
+}
+
 func testMap[T utils.Signed](t *testing.T) { //nolint: thelper
 	t.Parallel()
 
@@ -106,3 +114,29 @@ func testAdjacentMap[T utils.Signed](t *testing.T) { //nolint: thelper
 		})
 	}
 }
+
+func testMerge[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+	testCases := map[string]struct {
+		input1 []T
+		input2 []T
+		comp   func(T, T) bool
+		want   []T
+	}{
+		"empty":        {comp: utils.Lt[T], want: []T{}},
+		"first empty":  {comp: utils.Lt[T], input2: []T{1, 2}, want: []T{1, 2}},
+		"second empty": {comp: utils.Lt[T], input1: []T{1, 2}, want: []T{1, 2}},
+		"interleaved":  {comp: utils.Lt[T], input1: []T{1, 3, 5}, input2: []T{2, 4, 6, 8}, want: []T{1, 2, 3, 4, 5, 6, 8}},
+		"repeated":     {comp: utils.Lt[T], input1: []T{1, 1, 3}, input2: []T{1, 3, 3}, want: []T{1, 1, 1, 3, 3, 3}},
+		"greater than": {comp: utils.Gt[T], input1: []T{9, 4}, input2: []T{7, 4, 0}, want: []T{9, 7, 4, 4, 0}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := make([]T, len(tc.input1)+len(tc.input2))
+			inplace.Merge(got, tc.input1, tc.input2, tc.comp)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
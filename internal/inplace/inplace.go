@@ -11,8 +11,8 @@ func Map[T, O any](dst []O, src []T, f func(T) O) {
 
 // Fill generates an array of length len, where arr[i] = t.
 func Fill[T any](dst []T, t T) {
-	for i := 0; i < len(dst); i++ {
-		dst = append(dst, t)
+	for i := range dst {
+		dst[i] = t
 	}
 }
 
@@ -25,6 +25,21 @@ func Generate[T any](dst []T, f func() T) []T {
 	return dst
 }
 
+// GenerateIndexed generates an array of length len, where arr[i] = f(i).
+// The function will be called in sequential order.
+func GenerateIndexed[T any](dst []T, f func(int) T) {
+	for i := range dst {
+		dst[i] = f(i)
+	}
+}
+
+// Reverse reverses the order of the items in the array.
+func Reverse[T any](arr []T) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
 // ZipWith takes two arrays of type []L and []R, and applies zip:LxR->O
 // elementwise to produce an array of type []O and length equal to the
 // length of the shortest input.
@@ -42,3 +57,35 @@ func AdjacentMap[T, M any](dst []M, src []T, f func(T, T) M) {
 	}
 	ZipWith(dst, src, src[1:], f)
 }
+
+// Merge combines two sorted slices into a single sorted slice with all
+// their elements. Length of dst must be the sum of the lengths of the inputs.
+//
+// The merge is stable: equivalent items keep their relative order, and
+// those from the first list preceed those from the second one.
+func Merge[T any](dst, first, second []T, comp func(T, T) bool) {
+	var f, s, i int
+	for ; f < len(first) && s < len(second); i++ {
+		if comp(second[s], first[f]) {
+			dst[i] = second[s]
+			s++
+			continue
+		}
+		dst[i] = first[f]
+		f++
+	}
+	i += copy(dst[i:], first[f:])
+	copy(dst[i:], second[s:])
+}
+
+// InclusiveScan applies the function fold:OxT->O cummulatively, starting
+// with the initial value init, and stores every intermediate result:
+//
+//	dst[i] = fold(fold(...fold(init, src[0]), ...), src[i])
+func InclusiveScan[T, O any](dst []O, src []T, fold func(O, T) O, init O) {
+	acc := init
+	for i, a := range src {
+		acc = fold(acc, a)
+		dst[i] = acc
+	}
+}
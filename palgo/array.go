@@ -10,12 +10,17 @@ import (
 // Map applies function f:T->O element-wise to generate another
 // array []O of the same size.
 func Map[T, O any](arr []T, f func(T) O) []O {
+	return MapWith(DefaultExecutor, arr, f)
+}
+
+// MapWith is the same as Map, but it distributes the work with the given executor.
+func MapWith[T, O any](exec Executor, arr []T, f func(T) O) []O {
 	o := make([]O, len(arr))
-	alloc := NewWorkDistribution(len(arr), 3)
+	alloc := exec.distribute(len(arr), 3)
 	if alloc.NWorkers() < 2 {
 		return algo.Map(arr, f)
 	}
-	alloc.Run(func(w WorkAlloc) {
+	alloc.mustRunChunks(func(w WorkAlloc) {
 		inplace.Map(o[w.Begin:w.End], arr[w.Begin:w.End], f)
 	})
 
@@ -24,24 +29,34 @@ func Map[T, O any](arr []T, f func(T) O) []O {
 
 // Foreach applies non-pure function f:T element-wise t modify the array.
 func Foreach[T any](arr []T, f func(*T)) {
-	dist := NewWorkDistribution(len(arr), 3)
+	ForeachWith(DefaultExecutor, arr, f)
+}
+
+// ForeachWith is the same as Foreach, but it distributes the work with the given executor.
+func ForeachWith[T any](exec Executor, arr []T, f func(*T)) {
+	dist := exec.distribute(len(arr), 3)
 	if dist.NWorkers() < 2 {
 		algo.Foreach(arr, f)
 		return
 	}
-	dist.Run(func(w WorkAlloc) {
+	dist.mustRunChunks(func(w WorkAlloc) {
 		algo.Foreach(arr[w.Begin:w.End], f)
 	})
 }
 
 // Fill generates an array of length len, where arr[i] = t.
 func Fill[T any](n int, t T) []T {
-	dist := NewWorkDistribution(n, 3)
+	return FillWith(DefaultExecutor, n, t)
+}
+
+// FillWith is the same as Fill, but it distributes the work with the given executor.
+func FillWith[T any](exec Executor, n int, t T) []T {
+	dist := exec.distribute(n, 3)
 	if dist.NWorkers() < 2 {
 		return algo.Fill(n, t)
 	}
 	o := make([]T, n)
-	dist.Run(func(w WorkAlloc) {
+	dist.mustRunChunks(func(w WorkAlloc) {
 		inplace.Fill(o[w.Begin:w.End], t)
 	})
 	return o
@@ -56,25 +71,162 @@ func Fill[T any](n int, t T) []T {
 // where n is the length of arr.
 // The fold must be associative.
 //
+// The chunks depend on the number of workers, so results can vary between
+// machines for folds that are only approximately associative, such as sums
+// of floats. Use TreeReduce if they must be reproducible.
+//
 // Example use: Sum the values
 //
 //	Reduce(arr, func(x,y int)int { return x+y }) # Option 1.
 //	Reduce(arr, Add[int])                        # Option 2.
 func Reduce[T any](arr []T, fold func(T, T) T, init T) T {
-	dist := NewWorkDistribution(len(arr), 3)
+	return ReduceWith(DefaultExecutor, arr, fold, init)
+}
+
+// ReduceWith is the same as Reduce, but it distributes the work with the given executor.
+func ReduceWith[T any](exec Executor, arr []T, fold func(T, T) T, init T) T {
+	dist := exec.distribute(len(arr), 3)
 	if dist.NWorkers() < 2 {
 		return algo.Reduce(arr, fold, init)
 	}
 
 	o := make([]T, dist.NWorkers())
 	dist.Run(func(w WorkAlloc) {
-		init := fold(arr[w.Begin], arr[w.Begin+1])
-		w.Begin += 2
-		o[w.WorkerID] = algo.Reduce(arr[w.Begin:w.End], fold, init)
+		o[w.WorkerID] = algo.Reduce(arr[w.Begin+1:w.End], fold, arr[w.Begin])
 	})
 	return algo.Reduce(o, fold, init)
 }
 
+// treeBlockSize is the number of items that TreeReduce folds sequentially,
+// before combining the results pairwise.
+const treeBlockSize = 1 << 10
+
+// TreeReduce applies the function fold:TxT->T cummulatively, starting with
+// the initial value init, like Reduce. The end result is equivalent to:
+//
+//	fold(fold(...fold(init, arr[0]), ..., arr[n-2]), arr[n-1])
+//
+// where n is the length of arr. The fold must be associative, but it need not
+// be commutative.
+//
+// Unlike Reduce, the order in which the items are folded only depends on the
+// length of arr: the array is split into blocks of a fixed size, which are
+// reduced on their own and then combined pairwise in a balanced tree. Hence,
+// results are bitwise reproducible regardless of the number of workers or the
+// schedule, even for sums of floats.
+func TreeReduce[T any](arr []T, fold func(T, T) T, init T) T {
+	return TreeReduceWith(DefaultExecutor, arr, fold, init)
+}
+
+// TreeReduceWith is the same as TreeReduce, but it distributes the work with the given executor.
+func TreeReduceWith[T any](exec Executor, arr []T, fold func(T, T) T, init T) T {
+	if len(arr) == 0 {
+		return init
+	}
+
+	// The executor splits the blocks, not the items.
+	nBlocks := roundUpDiv(len(arr), treeBlockSize)
+	exec.MinChunk = roundUpDiv(exec.MinChunk, treeBlockSize)
+
+	partial := make([]T, nBlocks)
+	exec.distribute(nBlocks, 1).mustRunChunks(func(w WorkAlloc) {
+		for b := w.Begin; b < w.End; b++ {
+			block := arr[b*treeBlockSize : utils.Min((b+1)*treeBlockSize, len(arr))]
+			partial[b] = algo.Reduce(block[1:], fold, block[0])
+		}
+	})
+
+	for len(partial) > 1 {
+		for i := 0; i+1 < len(partial); i += 2 {
+			partial[i/2] = fold(partial[i], partial[i+1])
+		}
+		if len(partial)%2 == 1 {
+			partial[len(partial)/2] = partial[len(partial)-1]
+		}
+		partial = partial[:roundUpDiv(len(partial), 2)]
+	}
+
+	return fold(init, partial[0])
+}
+
+// InclusiveScan applies the function fold:TxT->T cummulatively,
+// starting with the initial value init, and returns every intermediate
+// result. Item i of the output is equivalent to:
+//
+//	fold(fold(...fold(init, arr[0]), ..., arr[i-1]), arr[i])
+//
+// The fold must be associative.
+//
+// It works in two passes: first every chunk is scanned on its own, and then
+// the chunks are shifted by the reduction of all the chunks before them.
+//
+// Example use: cumulative sum
+//
+//	InclusiveScan([]int{1, 2, 3}, utils.Add[int], 0) // Returns [1, 3, 6]
+func InclusiveScan[T any](arr []T, fold func(T, T) T, init T) []T {
+	return InclusiveScanWith(DefaultExecutor, arr, fold, init)
+}
+
+// InclusiveScanWith is the same as InclusiveScan, but it distributes the work with the given executor.
+func InclusiveScanWith[T any](exec Executor, arr []T, fold func(T, T) T, init T) []T {
+	o := make([]T, len(arr))
+	scan(exec, o, arr, fold, init)
+	return o
+}
+
+// ExclusiveScan is the same as InclusiveScan, except that item i of the
+// output does not include arr[i]. Hence, the first item is init:
+//
+//	fold(fold(...fold(init, arr[0]), ..., arr[i-2]), arr[i-1])
+//
+// The fold must be associative.
+//
+// Example use: offsets of consecutive buckets given their sizes
+//
+//	ExclusiveScan([]int{1, 2, 3}, utils.Add[int], 0) // Returns [0, 1, 3]
+func ExclusiveScan[T any](arr []T, fold func(T, T) T, init T) []T {
+	return ExclusiveScanWith(DefaultExecutor, arr, fold, init)
+}
+
+// ExclusiveScanWith is the same as ExclusiveScan, but it distributes the work with the given executor.
+func ExclusiveScanWith[T any](exec Executor, arr []T, fold func(T, T) T, init T) []T {
+	if len(arr) == 0 {
+		return []T{}
+	}
+	o := make([]T, len(arr))
+	o[0] = init
+	scan(exec, o[1:], arr[:len(arr)-1], fold, init)
+	return o
+}
+
+// scan stores the inclusive scan of src into dst.
+func scan[T any](exec Executor, dst, src []T, fold func(T, T) T, init T) {
+	dist := exec.distribute(len(src), 3)
+	if dist.NWorkers() < 2 {
+		inplace.InclusiveScan(dst, src, fold, init)
+		return
+	}
+
+	// First pass: scan every chunk on its own.
+	dist.Run(func(w WorkAlloc) {
+		inplace.InclusiveScan(dst[w.Begin+1:w.End], src[w.Begin+1:w.End], fold, src[w.Begin])
+		dst[w.Begin] = src[w.Begin]
+	})
+
+	// Every chunk is shifted by the fold of all chunks before it.
+	offsets := make([]T, dist.NWorkers())
+	offsets[0] = init
+	for i, w := range dist.Work[:len(offsets)-1] {
+		offsets[i+1] = fold(offsets[i], dst[w.End-1])
+	}
+
+	// Second pass: shift every chunk.
+	dist.Run(func(w WorkAlloc) {
+		offset := offsets[w.WorkerID]
+		inplace.Map(dst[w.Begin:w.End], dst[w.Begin:w.End], func(t T) T { return fold(offset, t) })
+	})
+}
+
 // MapReduce maps with the unary operator unary:T->O, producing an
 // intermediate array []O that is then reduced with an associative
 // fold:OxO->O.
@@ -85,16 +237,19 @@ func Reduce[T any](arr []T, fold func(T, T) T, init T) T {
 //
 // Note: the intermediate array is not stored in memory.
 func MapReduce[T, O any](arr []T, unary func(T) O, fold func(O, O) O, init O) O {
-	dist := NewWorkDistribution(len(arr), 3)
+	return MapReduceWith(DefaultExecutor, arr, unary, fold, init)
+}
+
+// MapReduceWith is the same as MapReduce, but it distributes the work with the given executor.
+func MapReduceWith[T, O any](exec Executor, arr []T, unary func(T) O, fold func(O, O) O, init O) O {
+	dist := exec.distribute(len(arr), 3)
 	if dist.NWorkers() < 2 {
 		return algo.MapReduce(arr, unary, fold, init)
 	}
 
 	o := make([]O, dist.NWorkers())
 	dist.Run(func(w WorkAlloc) {
-		init := fold(unary(arr[w.Begin]), unary(arr[w.Begin+1]))
-		w.Begin += 2
-		o[w.WorkerID] = algo.MapReduce(arr[w.Begin:w.End], unary, fold, init)
+		o[w.WorkerID] = algo.MapReduce(arr[w.Begin+1:w.End], unary, fold, unary(arr[w.Begin]))
 	})
 	return algo.Reduce(o, fold, init)
 }
@@ -103,14 +258,19 @@ func MapReduce[T, O any](arr []T, unary func(T) O, fold func(O, O) O, init O) O
 // elementwise to produce an array of type []O and length equal to the
 // length of the shortest input.
 func ZipWith[L, R, O any](first []L, second []R, f func(L, R) O) []O {
+	return ZipWithWith(DefaultExecutor, first, second, f)
+}
+
+// ZipWithWith is the same as ZipWith, but it distributes the work with the given executor.
+func ZipWithWith[L, R, O any](exec Executor, first []L, second []R, f func(L, R) O) []O {
 	ln := utils.Min(len(first), len(second))
-	dist := NewWorkDistribution(ln, 3)
+	dist := exec.distribute(ln, 3)
 	if dist.NWorkers() < 2 {
 		return algo.ZipWith(first, second, f)
 	}
 
 	o := make([]O, ln)
-	dist.Run(func(w WorkAlloc) {
+	dist.mustRunChunks(func(w WorkAlloc) {
 		inplace.ZipWith(o[w.Begin:w.End], first[w.Begin:w.End], second[w.Begin:w.End], f)
 	})
 	return o
@@ -136,20 +296,30 @@ func ZipReduce[L, R, O any](
 	zip func(L, R) O,
 	fold func(O, O) O,
 	init O,
+) O {
+	return ZipReduceWith(DefaultExecutor, first, second, zip, fold, init)
+}
+
+// ZipReduceWith is the same as ZipReduce, but it distributes the work with the given executor.
+func ZipReduceWith[L, R, O any](
+	exec Executor,
+	first []L,
+	second []R,
+	zip func(L, R) O,
+	fold func(O, O) O,
+	init O,
 ) O {
 	ln := utils.Min(len(first), len(second))
-	dist := NewWorkDistribution(ln, 3)
+	dist := exec.distribute(ln, 3)
 	if dist.NWorkers() < 2 {
 		return algo.ZipReduce(first, second, zip, fold, init)
 	}
 
 	o := make([]O, dist.NWorkers())
 	dist.Run(func(w WorkAlloc) {
-		a := zip(first[w.Begin], second[w.Begin])
-		w.Begin++
-		b := zip(first[w.Begin], second[w.Begin])
+		init := zip(first[w.Begin], second[w.Begin])
 		w.Begin++
-		o[w.WorkerID] = algo.ZipReduce(first[w.Begin:w.End], second[w.Begin:w.End], zip, fold, fold(a, b))
+		o[w.WorkerID] = algo.ZipReduce(first[w.Begin:w.End], second[w.Begin:w.End], zip, fold, init)
 	})
 
 	return algo.Reduce(o, fold, init)
@@ -1,6 +1,8 @@
 package palgo_test
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/EduardGomezEscandell/algo/algo"
@@ -25,6 +27,14 @@ func TestReduce(t *testing.T) {
 	t.Run("int64", testReduce[int64])
 }
 
+func TestScan(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testScan[int])
+	t.Run("int8", testScan[int8])
+	t.Run("int32", testScan[int32])
+	t.Run("int64", testScan[int64])
+}
+
 func TestMapReduce(t *testing.T) {
 	t.Parallel()
 	t.Run("int", testMapReduce[int])
@@ -94,6 +104,77 @@ func testReduce[T utils.Signed](t *testing.T) { //nolint: thelper // nolint: the
 	}
 }
 
+func TestTreeReduce(t *testing.T) {
+	t.Parallel()
+
+	executors := []palgo.Executor{
+		palgo.DefaultExecutor,
+		{MaxWorkers: 1},
+		{MaxWorkers: 3},
+		{MaxWorkers: 8, MinChunk: 1},
+		{MaxWorkers: 8, MinChunk: 5000},
+		{MaxWorkers: 8, MinChunk: 1, Schedule: palgo.WorkStealing},
+	}
+
+	concat := func(x, y string) string { return x + y }
+
+	for _, size := range []int{0, 1, 1023, 1024, 1025, 5000, 12_345} {
+		size := size
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(int64(size))) //nolint: gosec // Deterministic tests.
+			words := algo.Generate(size, func() string { return fmt.Sprint(rng.Intn(100), " ") })
+			floats := algo.Generate(size, func() float64 { return rng.ExpFloat64() * float64(rng.Intn(1_000_000)) })
+
+			// Non-commutative folds must match the sequential reduction, with init applied once.
+			want := algo.Reduce(words, concat, "init ")
+			floatSum := palgo.TreeReduce(floats, utils.Add[float64], 1)
+
+			for _, exec := range executors {
+				require.Equal(t, want, palgo.TreeReduceWith(exec, words, concat, "init "), "Wrong TreeReduceWith with %+v", exec)
+				require.Equal(t, want, palgo.ReduceWith(exec, words, concat, "init "), "Wrong ReduceWith with %+v", exec)
+
+				// Float sums must be bitwise reproducible.
+				require.Equal(t, floatSum, palgo.TreeReduceWith(exec, floats, utils.Add[float64], 1), "TreeReduceWith is not reproducible with %+v", exec)
+			}
+		})
+	}
+}
+
+func testScan[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	// Composition of affine functions x -> a·x + b is associative but not commutative.
+	compose := func(f, g [2]T) [2]T { return [2]T{g[0] * f[0], g[0]*f[1] + g[1]} }
+
+	testCases := map[string]struct {
+		size int
+		fold func([2]T, [2]T) [2]T
+		init [2]T
+	}{
+		"empty":             {size: 0, fold: compose, init: [2]T{1, 0}},
+		"single":            {size: 1, fold: compose, init: [2]T{2, 1}},
+		"small":             {size: 7, fold: compose, init: [2]T{1, 0}},
+		"large":             {size: 10_000, fold: compose, init: [2]T{-1, 3}},
+		"large, sum":        {size: 10_000, fold: func(x, y [2]T) [2]T { return [2]T{x[0] + y[0], x[1] + y[1]} }},
+		"large, odd length": {size: 12_345, fold: compose, init: [2]T{1, 5}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			input := algo.Generate(tc.size, func() [2]T { return [2]T{T(rng.Intn(5) - 2), T(rng.Intn(5) - 2)} })
+
+			require.Equal(t, algo.InclusiveScan(input, tc.fold, tc.init), palgo.InclusiveScan(input, tc.fold, tc.init), "Wrong inclusive scan")
+			require.Equal(t, algo.ExclusiveScan(input, tc.fold, tc.init), palgo.ExclusiveScan(input, tc.fold, tc.init), "Wrong exclusive scan")
+		})
+	}
+}
+
 func testMapReduce[T utils.Signed](t *testing.T) { //nolint: thelper
 	t.Parallel()
 
@@ -0,0 +1,62 @@
+package palgo
+
+import (
+	"sync/atomic"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+)
+
+// ForEachCombination calls f with every combination of k items from arr, like
+// algo.ForEachCombination, but concurrently. It panics if k is negative, or if
+// the number of combinations does not fit in an int.
+//
+// The combinations are split among the workers by their lexicographic rank.
+// Every worker computes the first combination in its range of ranks, and
+// then generates the rest in order. Hence, f is called concurrently, in no
+// particular order, and every worker passes its own slice to it. Once f
+// returns false, the workers stop soon after.
+//
+// Complexity is O(k) per combination.
+func ForEachCombination[T any](arr []T, k int, f func([]T) bool) {
+	ForEachCombinationWith(DefaultExecutor, arr, k, f)
+}
+
+// ForEachCombinationWith is the same as ForEachCombination, but it distributes the work with the given executor.
+func ForEachCombinationWith[T any](exec Executor, arr []T, k int, f func([]T) bool) {
+	if k < 0 || k > len(arr) {
+		algo.ForEachCombination(arr, k, f)
+		return
+	}
+
+	total, ok := algo.Binomial(len(arr), k)
+	if !ok {
+		panic("too many combinations")
+	}
+
+	dist := exec.distribute(total, 3)
+	if dist.NWorkers() < 2 {
+		algo.ForEachCombination(arr, k, f)
+		return
+	}
+
+	var stop int32
+	dist.mustRunChunks(func(w WorkAlloc) {
+		indices := algo.UnrankCombination(len(arr), k, w.Begin)
+
+		combination := make([]T, k)
+		for rank := w.Begin; rank < w.End; rank++ {
+			if atomic.LoadInt32(&stop) != 0 {
+				return
+			}
+			if rank != w.Begin {
+				algo.NextCombination(indices, len(arr))
+			}
+			inplace.Map(combination, indices, func(i int) T { return arr[i] })
+			if !f(combination) {
+				atomic.StoreInt32(&stop, 1)
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package palgo_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachCombination(t *testing.T) {
+	t.Parallel()
+
+	executors := testExecutors()
+	executors["dynamic"] = palgo.Executor{MaxWorkers: 8, MinChunk: 2, Schedule: palgo.Dynamic}
+
+	for name, exec := range executors {
+		for _, n := range []int{0, 1, 5, 12} {
+			for _, k := range []int{0, 1, 3, n, n + 1} {
+				t.Run(fmt.Sprintf("%s, %d choose %d", name, n, k), func(t *testing.T) {
+					t.Parallel()
+
+					input := algo.Generate(n, counter(0, 1))
+
+					want := [][]int{}
+					algo.ForEachCombination(input, k, func(c []int) bool {
+						want = append(want, append([]int{}, c...))
+						return true
+					})
+
+					var mu sync.Mutex
+					got := make([][]int, 0, len(want))
+					palgo.ForEachCombinationWith(exec, input, k, func(c []int) bool {
+						mu.Lock()
+						defer mu.Unlock()
+						got = append(got, append([]int{}, c...))
+						return true
+					})
+
+					require.ElementsMatch(t, want, got, "Every combination should be visited exactly once")
+				})
+			}
+		}
+	}
+}
+
+func TestForEachCombinationStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	input := algo.Generate(30, counter(0, 1))
+	exec := palgo.Executor{MaxWorkers: 4, MinChunk: 1}
+
+	var calls int64
+	palgo.ForEachCombinationWith(exec, input, 5, func(c []int) bool {
+		return atomic.AddInt64(&calls, 1) < 100
+	})
+
+	// There are 142506 combinations.
+	require.Less(t, atomic.LoadInt64(&calls), int64(10_000), "Workers should have stopped early")
+	require.GreaterOrEqual(t, atomic.LoadInt64(&calls), int64(100))
+
+	require.Panics(t, func() { palgo.ForEachCombination(make([]int, 200), 100, func([]int) bool { return true }) }, "Too many combinations should panic")
+	require.Panics(t, func() { palgo.ForEachCombination(input, -1, func([]int) bool { return true }) })
+}
@@ -0,0 +1,61 @@
+package palgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PanicError is a panic that was recovered inside a worker goroutine.
+type PanicError struct {
+	WorkerID int
+	Value    any
+	Stack    []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in worker %d: %v", e.WorkerID, e.Value)
+}
+
+// Unwrap returns the value of the panic if it is an error, and nil otherwise.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// AggregateError contains the errors returned by one or more workers.
+type AggregateError []error
+
+func (e AggregateError) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msg := make([]string, len(e))
+	for i, err := range e {
+		msg[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors: %s", len(e), strings.Join(msg, "; "))
+}
+
+// Is returns true if any of the aggregated errors matches the target.
+func (e AggregateError) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the aggregated errors that matches the target,
+// and if one is found, sets target to that error value and returns true.
+func (e AggregateError) As(target any) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package palgo
+
+import (
+	"runtime"
+)
+
+// Executor configures how palgo functions distribute their work among
+// goroutines. The zero value is ready to use.
+//
+// Every function has a variant with the suffix With that takes an executor.
+// The ones without it use DefaultExecutor.
+type Executor struct {
+	// MaxWorkers is the largest number of goroutines launched per call. If
+	// it is not positive, GOMAXPROCS is used.
+	MaxWorkers int
+
+	// MinChunk is the smallest number of items assigned to a worker. If it is
+	// not positive, every function uses its own default. Increase it for cheap
+	// functions, where the overhead of a goroutine outweighs the work done.
+	MinChunk int
+
+	// Schedule is the strategy used to assign work to the workers. Only
+	// functions that process every item independently, such as Map or
+	// Foreach, take it into account. The rest always use Static.
+	Schedule Schedule
+
+	// Pool runs the workers. If nil, new goroutines are launched on every call.
+	Pool *Pool
+}
+
+// DefaultExecutor is the executor used by the functions without the
+// suffix With.
+var DefaultExecutor = Executor{}
+
+// distribute splits a workload among the workers of the executor. The
+// minimum chunk size defaults to defaultMinChunk if the executor has none.
+func (e Executor) distribute(workload, defaultMinChunk int) WorkDistribution {
+	minChunk := e.MinChunk
+	if minChunk <= 0 {
+		minChunk = defaultMinChunk
+	}
+	dist := newWorkDistribution(workload, minChunk, e.maxWorkers())
+	dist.pool = e.Pool
+	dist.schedule = e.Schedule
+	dist.minChunk = minChunk
+	return dist
+}
+
+// maxWorkers is the largest number of goroutines the executor launches.
+func (e Executor) maxWorkers() int {
+	if e.MaxWorkers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return e.MaxWorkers
+}
@@ -0,0 +1,72 @@
+package palgo_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		exec palgo.Executor
+	}{
+		"default":              {exec: palgo.DefaultExecutor},
+		"single worker":        {exec: palgo.Executor{MaxWorkers: 1}},
+		"many workers":         {exec: palgo.Executor{MaxWorkers: 4}},
+		"single-item chunks":   {exec: palgo.Executor{MaxWorkers: 64, MinChunk: 1}},
+		"chunk larger than it": {exec: palgo.Executor{MaxWorkers: 4, MinChunk: 1_000_000}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, size := range []int{0, 1, 7, 50, 10_000} {
+				rng := rand.New(rand.NewSource(int64(size))) //nolint: gosec // Deterministic tests.
+				input := algo.Generate(size, func() int { return rng.Intn(100) })
+				even := func(x int) bool { return x%2 == 0 }
+				negate := func(x int) int { return -x }
+
+				require.Equal(t, algo.Map(input, negate), palgo.MapWith(tc.exec, input, negate), "Wrong MapWith")
+				require.Equal(t, algo.Reduce(input, utils.Add[int], 5), palgo.ReduceWith(tc.exec, input, utils.Add[int], 5), "Wrong ReduceWith")
+				require.Equal(t, algo.MapReduce(input, negate, utils.Add[int], 5),
+					palgo.MapReduceWith(tc.exec, input, negate, utils.Add[int], 5), "Wrong MapReduceWith")
+				require.Equal(t, algo.ZipReduce(input, input, utils.Mul[int], utils.Add[int], 5),
+					palgo.ZipReduceWith(tc.exec, input, input, utils.Mul[int], utils.Add[int], 5), "Wrong ZipReduceWith")
+				require.Equal(t, algo.ZipWith(input, input, utils.Mul[int]), palgo.ZipWithWith(tc.exec, input, input, utils.Mul[int]), "Wrong ZipWithWith")
+				require.Equal(t, algo.InclusiveScan(input, utils.Add[int], 5), palgo.InclusiveScanWith(tc.exec, input, utils.Add[int], 5), "Wrong InclusiveScanWith")
+				require.Equal(t, algo.ExclusiveScan(input, utils.Add[int], 5), palgo.ExclusiveScanWith(tc.exec, input, utils.Add[int], 5), "Wrong ExclusiveScanWith")
+				require.Equal(t, algo.Filter(input, even), palgo.FilterWith(tc.exec, input, even), "Wrong FilterWith")
+				require.Equal(t, algo.FindIf(input, even), palgo.FindIfWith(tc.exec, input, even), "Wrong FindIfWith")
+
+				got, err := palgo.MapErrWith(context.Background(), tc.exec, input, func(x int) (int, error) { return -x, nil })
+				require.NoError(t, err, "MapErrWith should not fail")
+				require.Equal(t, algo.Map(input, negate), got, "Wrong MapErrWith")
+
+				want := append([]int{}, input...)
+				algo.Sort(want, utils.Lt[int])
+				got = append([]int{}, input...)
+				palgo.SortWith(tc.exec, got, utils.Lt[int])
+				require.Equal(t, want, got, "Wrong SortWith")
+			}
+		})
+	}
+}
+
+// testExecutors returns the executors that tests run their algorithms with.
+// The map is new on every call, so tests can add executors of their own.
+func testExecutors() map[string]palgo.Executor {
+	return map[string]palgo.Executor{
+		"default":            palgo.DefaultExecutor,
+		"many workers":       {MaxWorkers: 8},
+		"single-item chunks": {MaxWorkers: 8, MinChunk: 1},
+	}
+}
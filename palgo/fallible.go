@@ -0,0 +1,118 @@
+package palgo
+
+import (
+	"context"
+)
+
+// MapErr applies the fallible function f:T->O element-wise to generate
+// another array []O of the same size.
+//
+// The first error, or the cancellation of the context, stops the workers
+// before they process any more items. The errors of all workers are then
+// returned as an AggregateError, and the output is discarded. Panics in the
+// workers are recovered and returned as errors too.
+func MapErr[T, O any](ctx context.Context, arr []T, f func(T) (O, error)) ([]O, error) {
+	return MapErrWith(ctx, DefaultExecutor, arr, f)
+}
+
+// MapErrWith is the same as MapErr, but it distributes the work with the given executor.
+func MapErrWith[T, O any](ctx context.Context, exec Executor, arr []T, f func(T) (O, error)) ([]O, error) {
+	o := make([]O, len(arr))
+	err := exec.distribute(len(arr), 3).runChunks(ctx, func(ctx context.Context, w WorkAlloc) error {
+		for i := w.Begin; i < w.End; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var err error
+			if o[i], err = f(arr[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ForeachErr applies the fallible, non-pure function f:T element-wise to
+// modify the array.
+//
+// The first error, or the cancellation of the context, stops the workers
+// before they process any more items. The errors of all workers are then
+// returned as an AggregateError, and the array is left partially modified.
+// Panics in the workers are recovered and returned as errors too.
+func ForeachErr[T any](ctx context.Context, arr []T, f func(*T) error) error {
+	return ForeachErrWith(ctx, DefaultExecutor, arr, f)
+}
+
+// ForeachErrWith is the same as ForeachErr, but it distributes the work with the given executor.
+func ForeachErrWith[T any](ctx context.Context, exec Executor, arr []T, f func(*T) error) error {
+	return exec.distribute(len(arr), 3).runChunks(ctx, func(ctx context.Context, w WorkAlloc) error {
+		for i := w.Begin; i < w.End; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := f(&arr[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ReduceErr applies the fallible function fold:TxT->T cummulatively,
+// starting with the initial value init. The end result is equivalent to:
+//
+//	fold(fold(...fold(init, arr[0]), ..., arr[n-2]), arr[n-1])
+//
+// where n is the length of arr. The fold must be associative.
+//
+// The first error, or the cancellation of the context, stops the workers
+// before they process any more items. The errors of all workers are then
+// returned as an AggregateError. Panics in the workers are recovered and
+// returned as errors too.
+func ReduceErr[T any](ctx context.Context, arr []T, fold func(T, T) (T, error), init T) (T, error) {
+	return ReduceErrWith(ctx, DefaultExecutor, arr, fold, init)
+}
+
+// ReduceErrWith is the same as ReduceErr, but it distributes the work with the given executor.
+func ReduceErrWith[T any](ctx context.Context, exec Executor, arr []T, fold func(T, T) (T, error), init T) (T, error) {
+	dist := exec.distribute(len(arr), 3)
+
+	partial := make([]T, dist.NWorkers())
+	err := dist.RunErr(ctx, func(ctx context.Context, w WorkAlloc) error {
+		// Only the first worker starts from init, the rest start from their first item.
+		acc := init
+		if w.WorkerID != 0 {
+			acc = arr[w.Begin]
+			w.Begin++
+		}
+
+		for i := w.Begin; i < w.End; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var err error
+			if acc, err = fold(acc, arr[i]); err != nil {
+				return err
+			}
+		}
+		partial[w.WorkerID] = acc
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	acc := partial[0]
+	for _, p := range partial[1:] {
+		if acc, err = fold(acc, p); err != nil {
+			var zero T
+			return zero, AggregateError{err}
+		}
+	}
+	return acc, nil
+}
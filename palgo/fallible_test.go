@@ -0,0 +1,201 @@
+package palgo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapErr(t *testing.T) {
+	t.Parallel()
+
+	errNegative := errors.New("negative input")
+	half := func(x int) (int, error) {
+		if x < 0 {
+			return 0, fmt.Errorf("could not halve %d: %w", x, errNegative)
+		}
+		return x / 2, nil
+	}
+
+	testCases := map[string]struct {
+		input   []int
+		f       func(int) (int, error)
+		cancel  bool
+		want    []int
+		wantErr error
+	}{
+		"empty":      {input: []int{}, f: half, want: []int{}},
+		"success":    {input: []int{0, 1, 4, 9, 15, 16}, f: half, want: []int{0, 0, 2, 4, 7, 8}},
+		"large":      {input: algo.Generate(10_000, counter(0, 1)), f: half, want: algo.Map(algo.Generate(10_000, counter(0, 1)), func(x int) int { return x / 2 })},
+		"error":      {input: []int{0, 1, -4, 9}, f: half, wantErr: errNegative},
+		"late error": {input: append(algo.Generate(10_000, counter(0, 1)), -1), f: half, wantErr: errNegative},
+		"panic":      {input: []int{1, 2, 3}, f: func(x int) (int, error) { panic("oh no") }, wantErr: &palgo.PanicError{}},
+		"cancelled":  {input: []int{1, 2, 3}, f: half, cancel: true, wantErr: context.Canceled},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancel {
+				cancel()
+			}
+
+			got, err := palgo.MapErr(ctx, tc.input, tc.f)
+			if tc.wantErr != nil {
+				requireErrorMatches(t, tc.wantErr, err)
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestForeachErr(t *testing.T) {
+	t.Parallel()
+
+	errTooLarge := errors.New("too large")
+	double := func(x *int) error {
+		if *x > 1000 {
+			return errTooLarge
+		}
+		*x *= 2
+		return nil
+	}
+
+	testCases := map[string]struct {
+		input   []int
+		f       func(*int) error
+		cancel  bool
+		want    []int
+		wantErr error
+	}{
+		"empty":     {input: []int{}, f: double, want: []int{}},
+		"success":   {input: []int{0, 1, 5}, f: double, want: []int{0, 2, 10}},
+		"error":     {input: []int{0, 1, 5000}, f: double, wantErr: errTooLarge},
+		"panic":     {input: []int{1, 2, 3}, f: func(x *int) error { panic(errTooLarge) }, wantErr: errTooLarge},
+		"cancelled": {input: []int{1, 2, 3}, f: double, cancel: true, wantErr: context.Canceled},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancel {
+				cancel()
+			}
+
+			arr := append([]int{}, tc.input...)
+			err := palgo.ForeachErr(ctx, arr, tc.f)
+			if tc.wantErr != nil {
+				requireErrorMatches(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, arr)
+		})
+	}
+}
+
+func TestReduceErr(t *testing.T) {
+	t.Parallel()
+
+	errOverflow := errors.New("overflow")
+	add := func(x, y int8) (int8, error) {
+		if int(x)+int(y) != int(x+y) {
+			return 0, errOverflow
+		}
+		return x + y, nil
+	}
+	concat := func(x, y string) (string, error) { return x + y, nil }
+
+	testCases := map[string]struct {
+		input   []int8
+		init    int8
+		want    int8
+		wantErr error
+	}{
+		"empty":         {input: []int8{}, init: 5, want: 5},
+		"single":        {input: []int8{3}, init: 5, want: 8},
+		"success":       {input: []int8{1, -2, 3, 7}, init: 1, want: 10},
+		"large":         {input: algo.Generate(10_000, func() int8 { return 0 }), init: 9, want: 9},
+		"error":         {input: []int8{100, 100, -100}, wantErr: errOverflow},
+		"error in init": {input: []int8{100}, init: 100, wantErr: errOverflow},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := palgo.ReduceErr(context.Background(), tc.input, add, tc.init)
+			if tc.wantErr != nil {
+				requireErrorMatches(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("order is preserved", func(t *testing.T) {
+		t.Parallel()
+
+		input := algo.Generate(10_000, func() string { return "ab" })
+		input[42] = "c"
+
+		want := algo.Reduce(input, func(x, y string) string { return x + y }, ">")
+		got, err := palgo.ReduceErr(context.Background(), input, concat, ">")
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+}
+
+func TestMapErrStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	const size = 1_000_000
+	errFound := errors.New("found it")
+
+	var calls int64
+	_, err := palgo.MapErr(context.Background(), algo.Generate(size, counter(0, 1)), func(x int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		if x%1000 == 0 {
+			return 0, errFound
+		}
+		return x, nil
+	})
+
+	require.ErrorIs(t, err, errFound)
+	require.Less(t, atomic.LoadInt64(&calls), int64(size/2), "Workers should have stopped early")
+}
+
+// requireErrorMatches checks that err matches the wanted error. PanicErrors
+// are matched by type.
+func requireErrorMatches(t *testing.T, want, err error) {
+	t.Helper()
+
+	var agg palgo.AggregateError
+	require.ErrorAs(t, err, &agg, "Errors should be aggregated")
+
+	var perr *palgo.PanicError
+	if errors.As(want, &perr) {
+		require.ErrorAs(t, err, &perr)
+		return
+	}
+	require.ErrorIs(t, err, want)
+}
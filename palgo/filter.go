@@ -0,0 +1,114 @@
+package palgo
+
+import (
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Filter returns a list with the items in arr that fulfil the predicate,
+// in their original order. The predicate is called exactly once per item.
+//
+// Every worker counts the items it keeps in its own chunk, and then copies
+// them to the output at the offset given by the workers before it.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr|).
+func Filter[T any](arr []T, pred utils.Predicate[T]) []T {
+	return FilterWith(DefaultExecutor, arr, pred)
+}
+
+// FilterWith is the same as Filter, but it distributes the work with the given executor.
+func FilterWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) []T {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.Filter(arr, pred)
+	}
+
+	keep, counts := classify(dist, arr, pred)
+	offsets := algo.ExclusiveScan(counts, utils.Add[int], 0)
+
+	o := make([]T, offsets[len(offsets)-1]+counts[len(counts)-1])
+	dist.Run(func(w WorkAlloc) {
+		i := offsets[w.WorkerID]
+		for j := w.Begin; j < w.End; j++ {
+			if keep[j] {
+				o[i] = arr[j]
+				i++
+			}
+		}
+	})
+	return o
+}
+
+// Partition rearranges a list such that
+//
+//	pred(arr[i]) is true <=> i < j
+//
+// and returns this value j. Unlike algo.Partition, the items on either side
+// keep their original relative order, like in algo.StablePartition. The
+// predicate is called exactly once per item.
+//
+// Every worker counts the items it keeps in its own chunk, and then moves
+// every item to the offset given by the workers before it.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr|).
+func Partition[T any](arr []T, pred utils.Predicate[T]) int {
+	return PartitionWith(DefaultExecutor, arr, pred)
+}
+
+// PartitionWith is the same as Partition, but it distributes the work with the given executor.
+func PartitionWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) int {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.StablePartition(arr, pred)
+	}
+
+	keep, counts := classify(dist, arr, pred)
+	accepted := algo.ExclusiveScan(counts, utils.Add[int], 0)
+	p := accepted[len(accepted)-1] + counts[len(counts)-1]
+
+	// Rejected items go after all accepted ones.
+	rejected := algo.ExclusiveScan(dist.Work, func(acc int, w WorkAlloc) int {
+		return acc + w.End - w.Begin - counts[w.WorkerID]
+	}, p)
+
+	buff := make([]T, len(arr))
+	dist.Run(func(w WorkAlloc) {
+		a, r := accepted[w.WorkerID], rejected[w.WorkerID]
+		for j := w.Begin; j < w.End; j++ {
+			if keep[j] {
+				buff[a] = arr[j]
+				a++
+			} else {
+				buff[r] = arr[j]
+				r++
+			}
+		}
+	})
+
+	dist.Run(func(w WorkAlloc) {
+		copy(arr[w.Begin:w.End], buff[w.Begin:w.End])
+	})
+	return p
+}
+
+// classify evaluates the predicate on every item, and counts how many
+// items fulfil it in every worker's chunk.
+func classify[T any](dist WorkDistribution, arr []T, pred utils.Predicate[T]) (keep []bool, counts []int) {
+	keep = make([]bool, len(arr))
+	counts = make([]int, dist.NWorkers())
+	dist.Run(func(w WorkAlloc) {
+		c := 0
+		for j := w.Begin; j < w.End; j++ {
+			keep[j] = pred(arr[j])
+			if keep[j] {
+				c++
+			}
+		}
+		counts[w.WorkerID] = c
+	})
+	return keep, counts
+}
@@ -0,0 +1,65 @@
+package palgo_test
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testFilter[int])
+	t.Run("int8", testFilter[int8])
+	t.Run("int32", testFilter[int32])
+	t.Run("int64", testFilter[int64])
+}
+
+func testFilter[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size int
+		pred utils.Predicate[T]
+	}{
+		"empty":             {size: 0, pred: func(x T) bool { return x < 50 }},
+		"small":             {size: 10, pred: func(x T) bool { return x < 50 }},
+		"large, half":       {size: 100_000, pred: func(x T) bool { return x < 50 }},
+		"large, few":        {size: 100_000, pred: func(x T) bool { return x == 7 }},
+		"large, all":        {size: 100_000, pred: func(x T) bool { return x >= 0 }},
+		"large, none":       {size: 100_000, pred: func(x T) bool { return x < 0 }},
+		"large, odd length": {size: 12_345, pred: func(x T) bool { return int(x)%3 == 0 }},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			input := algo.Generate(tc.size, func() T { return T(rng.Intn(100)) })
+
+			var calls int64
+			pred := func(x T) bool {
+				atomic.AddInt64(&calls, 1)
+				return tc.pred(x)
+			}
+
+			require.Equal(t, algo.Filter(input, tc.pred), palgo.Filter(input, pred), "Wrong filter")
+			require.Equal(t, int64(tc.size), calls, "Predicate must be called once per item")
+
+			want := append([]T{}, input...)
+			wantP := algo.StablePartition(want, tc.pred)
+
+			got := append([]T{}, input...)
+			gotP := palgo.Partition(got, pred)
+			require.Equal(t, wantP, gotP, "Wrong partition point")
+			require.Equal(t, want, got, "Wrong partition")
+			require.Equal(t, int64(2*tc.size), calls, "Predicate must be called once per item")
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package palgo
+
+import (
+	"math/rand"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// randBlockSize is the number of items that GenerateRand generates with the
+// same random source.
+const randBlockSize = 1 << 10
+
+// GenerateIndexed generates an array of length n, where arr[i] = f(i).
+// The function is called concurrently, in no particular order.
+func GenerateIndexed[T any](n int, f func(int) T) []T {
+	return GenerateIndexedWith(DefaultExecutor, n, f)
+}
+
+// GenerateIndexedWith is the same as GenerateIndexed, but it distributes the work with the given executor.
+func GenerateIndexedWith[T any](exec Executor, n int, f func(int) T) []T {
+	return generateIndexed(exec, n, 3, f)
+}
+
+// GenerateIndexed2D generates a 2D array of lengths n x m, where
+// arr[i][j] = f(i, j). The rows are generated concurrently, in no particular
+// order.
+func GenerateIndexed2D[T any](n, m int, f func(i, j int) T) [][]T {
+	return GenerateIndexed2DWith(DefaultExecutor, n, m, f)
+}
+
+// GenerateIndexed2DWith is the same as GenerateIndexed2D, but it distributes the work with the given executor.
+func GenerateIndexed2DWith[T any](exec Executor, n, m int, f func(i, j int) T) [][]T {
+	return generateIndexed(exec, n, 1, func(i int) []T {
+		return algo.GenerateIndexed(m, func(j int) T { return f(i, j) })
+	})
+}
+
+// GenerateIndexed3D generates a 3D array of lengths n x m x p, where
+// arr[i][j][k] = f(i, j, k). The outermost slices are generated concurrently,
+// in no particular order.
+func GenerateIndexed3D[T any](n, m, p int, f func(i, j, k int) T) [][][]T {
+	return GenerateIndexed3DWith(DefaultExecutor, n, m, p, f)
+}
+
+// GenerateIndexed3DWith is the same as GenerateIndexed3D, but it distributes the work with the given executor.
+func GenerateIndexed3DWith[T any](exec Executor, n, m, p int, f func(i, j, k int) T) [][][]T {
+	return generateIndexed(exec, n, 1, func(i int) [][]T {
+		return algo.GenerateIndexed(m, func(j int) []T {
+			return algo.GenerateIndexed(p, func(k int) T { return f(i, j, k) })
+		})
+	})
+}
+
+// GenerateRand generates an array of length n, where every item is generated
+// by calling f with a random source.
+//
+// The array is split into blocks of a fixed size, and the items in every block
+// are generated in order, with a source seeded from seed and the index of the
+// block. Hence, the output only depends on n and seed, regardless of the number
+// of workers or the schedule.
+func GenerateRand[T any](n int, seed int64, f func(*rand.Rand) T) []T {
+	return GenerateRandWith(DefaultExecutor, n, seed, f)
+}
+
+// GenerateRandWith is the same as GenerateRand, but it distributes the work with the given executor.
+func GenerateRandWith[T any](exec Executor, n int, seed int64, f func(*rand.Rand) T) []T {
+	nBlocks := roundUpDiv(n, randBlockSize)
+	seeds := algo.Generate(nBlocks, rand.New(rand.NewSource(seed)).Int63) //nolint: gosec // Reproducibility requires seeded sources.
+
+	// The executor splits the blocks, not the items.
+	exec.MinChunk = roundUpDiv(exec.MinChunk, randBlockSize)
+
+	o := make([]T, n)
+	exec.distribute(nBlocks, 1).mustRunChunks(func(w WorkAlloc) {
+		for b := w.Begin; b < w.End; b++ {
+			rng := rand.New(rand.NewSource(seeds[b])) //nolint: gosec // Reproducibility requires seeded sources.
+			block := o[b*randBlockSize : utils.Min((b+1)*randBlockSize, n)]
+			inplace.Generate(block, func() T { return f(rng) })
+		}
+	})
+	return o
+}
+
+// generateIndexed is the same as GenerateIndexedWith, with a custom default
+// for the minimum chunk size.
+func generateIndexed[T any](exec Executor, n, defaultMinChunk int, f func(int) T) []T {
+	dist := exec.distribute(n, defaultMinChunk)
+	if dist.NWorkers() < 2 {
+		return algo.GenerateIndexed(n, f)
+	}
+
+	o := make([]T, n)
+	dist.mustRunChunks(func(w WorkAlloc) {
+		for i := w.Begin; i < w.End; i++ {
+			o[i] = f(i)
+		}
+	})
+	return o
+}
@@ -0,0 +1,69 @@
+package palgo_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	executors := testExecutors()
+	executors["single worker"] = palgo.Executor{MaxWorkers: 1}
+	executors["large chunks"] = palgo.Executor{MaxWorkers: 8, MinChunk: 5000}
+	executors["work stealing"] = palgo.Executor{MaxWorkers: 8, MinChunk: 1, Schedule: palgo.WorkStealing}
+
+	for name, exec := range executors {
+		exec := exec
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, n := range []int{0, 1, 7, 100} {
+				require.Equal(t, algo.Fill(n, 42), palgo.FillWith(exec, n, 42), "Wrong FillWith")
+				require.Equal(t, algo.Generate(n, counter(0, 3)), palgo.GenerateIndexedWith(exec, n, func(i int) int { return 3 * i }), "Wrong GenerateIndexedWith")
+
+				want2D := algo.Generate(n, func() []int { return algo.Generate(5, counter(0, 1)) })
+				require.Equal(t, want2D, palgo.GenerateIndexed2DWith(exec, n, 5, func(i, j int) int { return j }), "Wrong GenerateIndexed2DWith")
+
+				want3D := algo.Generate3D(n, 2, 3, func() int { return 0 })
+				for i := range want3D {
+					for j := range want3D[i] {
+						for k := range want3D[i][j] {
+							want3D[i][j][k] = 100*i + 10*j + k
+						}
+					}
+				}
+				require.Equal(t, want3D, palgo.GenerateIndexed3DWith(exec, n, 2, 3, func(i, j, k int) int { return 100*i + 10*j + k }), "Wrong GenerateIndexed3DWith")
+			}
+		})
+	}
+}
+
+func TestGenerateRand(t *testing.T) {
+	t.Parallel()
+
+	executors := []palgo.Executor{
+		palgo.DefaultExecutor,
+		{MaxWorkers: 1},
+		{MaxWorkers: 3},
+		{MaxWorkers: 8, MinChunk: 1},
+		{MaxWorkers: 8, MinChunk: 1, Schedule: palgo.Dynamic},
+	}
+
+	for _, n := range []int{0, 1, 1024, 1025, 10_000} {
+		want := palgo.GenerateRand(n, 42, (*rand.Rand).Int)
+		require.Len(t, want, n)
+
+		for _, exec := range executors {
+			require.Equal(t, want, palgo.GenerateRandWith(exec, n, 42, (*rand.Rand).Int), "Output should not depend on the executor %+v", exec)
+		}
+
+		if n > 1 {
+			require.NotEqual(t, want, palgo.GenerateRand(n, 43, (*rand.Rand).Int), "Output should depend on the seed")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package palgo
+
+import (
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// GroupBy classifies the items in arr according to their key. Items keep
+// their relative order within every group.
+//
+// Every worker groups its own chunk, and then the groups are concatenated
+// in order.
+//
+// Complexity is O(|arr|).
+func GroupBy[T any, K comparable](arr []T, key func(T) K) map[K][]T {
+	return GroupByWith(DefaultExecutor, arr, key)
+}
+
+// GroupByWith is the same as GroupBy, but it distributes the work with the given executor.
+func GroupByWith[T any, K comparable](exec Executor, arr []T, key func(T) K) map[K][]T {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.GroupBy(arr, key)
+	}
+
+	partial := make([]map[K][]T, dist.NWorkers())
+	dist.Run(func(w WorkAlloc) {
+		partial[w.WorkerID] = algo.GroupBy(arr[w.Begin:w.End], key)
+	})
+
+	groups := partial[0]
+	for _, p := range partial[1:] {
+		for k, g := range p {
+			groups[k] = append(groups[k], g...)
+		}
+	}
+	return groups
+}
+
+// CountBy counts how many items in arr have every key.
+//
+// Every worker counts the keys in its own chunk, and then the counts are
+// added up.
+//
+// Complexity is O(|arr|).
+func CountBy[T any, K comparable](arr []T, key func(T) K) map[K]int {
+	return CountByWith(DefaultExecutor, arr, key)
+}
+
+// CountByWith is the same as CountBy, but it distributes the work with the given executor.
+func CountByWith[T any, K comparable](exec Executor, arr []T, key func(T) K) map[K]int {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.CountBy(arr, key)
+	}
+
+	partial := make([]map[K]int, dist.NWorkers())
+	dist.Run(func(w WorkAlloc) {
+		partial[w.WorkerID] = algo.CountBy(arr[w.Begin:w.End], key)
+	})
+
+	counts := partial[0]
+	for _, p := range partial[1:] {
+		for k, c := range p {
+			counts[k] += c
+		}
+	}
+	return counts
+}
+
+// Histogram counts how many items in arr fall into every bin. See
+// algo.Histogram for the meaning of the edges.
+//
+// Every worker counts the items in its own chunk, and then the counts are
+// added up.
+//
+// Complexity is O(|arr|·log|edges|).
+func Histogram[T utils.Number](arr []T, edges []T) []int {
+	return HistogramWith(DefaultExecutor, arr, edges)
+}
+
+// HistogramWith is the same as Histogram, but it distributes the work with the given executor.
+func HistogramWith[T utils.Number](exec Executor, arr []T, edges []T) []int {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.Histogram(arr, edges)
+	}
+
+	partial := make([][]int, dist.NWorkers())
+	dist.Run(func(w WorkAlloc) {
+		partial[w.WorkerID] = algo.Histogram(arr[w.Begin:w.End], edges)
+	})
+
+	counts := partial[0]
+	for _, p := range partial[1:] {
+		inplace.ZipWith(counts, counts, p, utils.Add[int])
+	}
+	return counts
+}
@@ -0,0 +1,40 @@
+package palgo_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupBy(t *testing.T) {
+	t.Parallel()
+
+	executors := testExecutors()
+
+	for name, exec := range executors {
+		exec := exec
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, size := range []int{0, 1, 7, 10_000} {
+				rng := rand.New(rand.NewSource(int64(size))) //nolint: gosec // Deterministic tests.
+
+				// The index is kept to check that the order within each group is preserved.
+				input := algo.Generate(size, func() [2]int { return [2]int{rng.Intn(10), rng.Int()} })
+				key := func(x [2]int) int { return x[0] }
+
+				require.Equal(t, algo.GroupBy(input, key), palgo.GroupByWith(exec, input, key), "Wrong GroupByWith")
+				require.Equal(t, algo.CountBy(input, key), palgo.CountByWith(exec, input, key), "Wrong CountByWith")
+
+				values := algo.Map(input, func(x [2]int) float64 { return float64(x[1]%1000) / 10 })
+				edges := []float64{0, 1, 2.5, 50, 99.9}
+				require.Equal(t, algo.Histogram(values, edges), palgo.HistogramWith(exec, values, edges), "Wrong HistogramWith")
+			}
+
+			require.Panics(t, func() { palgo.HistogramWith(exec, make([]int, 100), []int{2, 1}) }, "Invalid edges should panic")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package palgo
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Pool is a set of long-lived goroutines onto which palgo functions can
+// dispatch their work, instead of launching new goroutines on every call.
+// Use it by setting the Pool field of an Executor.
+//
+// Work is only handed to idle workers. If all of them are busy, the calling
+// goroutine does the work itself. Hence, palgo functions can be nested inside
+// one another using the same pool without deadlocking.
+type Pool struct {
+	tasks chan func()
+	wg    sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewPool launches a pool with n workers. If n is not positive, GOMAXPROCS
+// workers are launched. Call Close to stop them.
+func NewPool(n int) *Pool {
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+
+	p := &Pool{tasks: make(chan func())}
+	p.wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer p.wg.Done()
+			for task := range p.tasks {
+				task()
+			}
+		}()
+	}
+	return p
+}
+
+// Close stops the workers once they finish their current work, and waits
+// for them. Functions using the pool afterwards still work, but in the
+// calling goroutine only. Calling Close more than once has no effect.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.tasks)
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+// tryGo executes the task in an idle worker, and returns false if there
+// are none. A nil pool launches a new goroutine instead.
+func (p *Pool) tryGo(task func()) bool {
+	if p == nil {
+		go task()
+		return true
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return false
+	}
+
+	select {
+	case p.tasks <- task:
+		return true
+	default:
+		return false
+	}
+}
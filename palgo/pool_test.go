@@ -0,0 +1,116 @@
+package palgo_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		poolSize   int
+		maxWorkers int
+	}{
+		"default size":              {},
+		"as many workers as pool":   {poolSize: 4, maxWorkers: 4},
+		"more workers than pool":    {poolSize: 2, maxWorkers: 8},
+		"fewer workers than pool":   {poolSize: 8, maxWorkers: 2},
+		"single goroutine for pool": {poolSize: 1, maxWorkers: 4},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := palgo.NewPool(tc.poolSize)
+			defer pool.Close()
+			exec := palgo.Executor{MaxWorkers: tc.maxWorkers, MinChunk: 1, Pool: pool}
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			input := algo.Generate(1000, func() int { return rng.Intn(100) })
+
+			// Many calls in a row, reusing the same goroutines.
+			for i := 0; i < 100; i++ {
+				require.Equal(t, algo.Reduce(input, utils.Add[int], i), palgo.ReduceWith(exec, input, utils.Add[int], i))
+			}
+
+			want := append([]int{}, input...)
+			algo.Sort(want, utils.Lt[int])
+			got := append([]int{}, input...)
+			palgo.SortWith(exec, got, utils.Lt[int])
+			require.Equal(t, want, got, "Wrong SortWith")
+
+			// Errors and panics do not kill the workers.
+			_, err := palgo.MapErrWith(context.Background(), exec, input, func(int) (int, error) { return 0, errors.New("oops") })
+			require.Error(t, err, "MapErrWith should have failed")
+			require.Panics(t, func() { palgo.ForeachWith(exec, input, func(*int) { panic("oops") }) }, "ForeachWith should have panicked")
+			require.Equal(t, algo.Reduce(input, utils.Add[int], 0), palgo.ReduceWith(exec, input, utils.Add[int], 0))
+		})
+	}
+}
+
+func TestPoolNested(t *testing.T) {
+	t.Parallel()
+
+	pool := palgo.NewPool(4)
+	defer pool.Close()
+	exec := palgo.Executor{MaxWorkers: 4, MinChunk: 1, Pool: pool}
+
+	// Every worker of the outer call uses the pool again.
+	rows := algo.Generate(50, func() []int { return algo.Generate(100, counter(0, 1)) })
+	sums := palgo.MapWith(exec, rows, func(row []int) int {
+		return palgo.ReduceWith(exec, row, utils.Add[int], 0)
+	})
+
+	require.Equal(t, algo.Generate(50, func() int { return 4950 }), sums)
+}
+
+func TestPoolClose(t *testing.T) {
+	t.Parallel()
+
+	pool := palgo.NewPool(4)
+	exec := palgo.Executor{MaxWorkers: 4, MinChunk: 1, Pool: pool}
+	input := algo.Generate(100, counter(0, 1))
+
+	require.Equal(t, 4950, palgo.ReduceWith(exec, input, utils.Add[int], 0))
+
+	pool.Close()
+	pool.Close()
+
+	// Once closed, the work is done by the calling goroutine.
+	require.Equal(t, 4950, palgo.ReduceWith(exec, input, utils.Add[int], 0))
+}
+
+func BenchmarkPool(b *testing.B) {
+	const size = 10_000
+
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	input := algo.Generate(size, rng.Int)
+	double := func(x int) int { return 2 * x }
+
+	pool := palgo.NewPool(0)
+	defer pool.Close()
+
+	executors := map[string]palgo.Executor{
+		"new goroutines": {MaxWorkers: 8, MinChunk: 1},
+		"pool":           {MaxWorkers: 8, MinChunk: 1, Pool: pool},
+	}
+
+	for name, exec := range executors {
+		exec := exec
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				palgo.MapWith(exec, input, double)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package palgo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Schedule is a strategy to assign work to workers.
+type Schedule int
+
+const (
+	// Static splits the work into one contiguous chunk per worker, all of
+	// them of roughly the same size. It has the least overhead, but one slow
+	// chunk leaves the rest of the workers idle.
+	Static Schedule = iota
+
+	// Dynamic splits the work into chunks of the minimum chunk size, which
+	// workers grab one at a time from a shared atomic counter until there
+	// are none left.
+	Dynamic
+
+	// Guided is the same as Dynamic, except that every chunk is the remaining
+	// work divided by the number of workers. Chunks start large, to keep the
+	// overhead low, and shrink down to the minimum chunk size, to balance the
+	// load at the end.
+	Guided
+
+	// WorkStealing starts like Static, but every worker processes its own
+	// chunk in pieces of the minimum chunk size. Workers that run out of work
+	// steal the second half of what is left of another worker's chunk.
+	WorkStealing
+)
+
+// String returns the name of the schedule.
+func (s Schedule) String() string {
+	switch s {
+	case Static:
+		return "Static"
+	case Dynamic:
+		return "Dynamic"
+	case Guided:
+		return "Guided"
+	case WorkStealing:
+		return "WorkStealing"
+	}
+	return fmt.Sprintf("Schedule(%d)", int(s))
+}
+
+// runChunks is the same as RunErr, except that the work is split according
+// to the schedule of the distribution, so f may be called more than once per
+// worker. Hence, it is only suitable for functions that process every item
+// independently.
+func (dist WorkDistribution) runChunks(ctx context.Context, f func(context.Context, WorkAlloc) error) error {
+	var next func(WorkAlloc) (WorkAlloc, bool)
+	switch dist.schedule {
+	case Static:
+		return dist.RunErr(ctx, f)
+	case Dynamic:
+		next = dist.dynamicChunks()
+	case Guided:
+		next = dist.guidedChunks()
+	case WorkStealing:
+		next = dist.stolenChunks()
+	default:
+		panic(fmt.Errorf("unknown schedule: %v", dist.schedule))
+	}
+
+	return dist.RunErr(ctx, func(ctx context.Context, w WorkAlloc) error {
+		for {
+			chunk, ok := next(w)
+			if !ok {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := f(ctx, chunk); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// mustRunChunks is the same as runChunks, except that panics inside the
+// goroutines are raised again in the calling goroutine, like in Run.
+func (dist WorkDistribution) mustRunChunks(f func(WorkAlloc)) {
+	repanic(dist.runChunks(context.Background(), func(_ context.Context, w WorkAlloc) error {
+		f(w)
+		return nil
+	}))
+}
+
+// workload is the total amount of work to distribute.
+func (dist WorkDistribution) workload() int {
+	return dist.Work[len(dist.Work)-1].End
+}
+
+// dynamicChunks returns a function that hands out chunks of the minimum
+// chunk size, in order, until there are none left.
+func (dist WorkDistribution) dynamicChunks() func(WorkAlloc) (WorkAlloc, bool) {
+	workload := int64(dist.workload())
+	size := int64(dist.minChunk)
+
+	var next int64
+	return func(w WorkAlloc) (WorkAlloc, bool) {
+		begin := atomic.AddInt64(&next, size) - size
+		if begin >= workload {
+			return w, false
+		}
+		end := utils.Min(begin+size, workload)
+		return WorkAlloc{WorkerID: w.WorkerID, Begin: int(begin), End: int(end)}, true
+	}
+}
+
+// guidedChunks returns a function that hands out chunks of the remaining
+// work divided by the number of workers, in order, until there are none left.
+func (dist WorkDistribution) guidedChunks() func(WorkAlloc) (WorkAlloc, bool) {
+	workload := dist.workload()
+	nWorkers := dist.NWorkers()
+
+	var next int64
+	return func(w WorkAlloc) (WorkAlloc, bool) {
+		for {
+			begin := int(atomic.LoadInt64(&next))
+			remaining := workload - begin
+			if remaining <= 0 {
+				return w, false
+			}
+			size := utils.Min(remaining, utils.Max(dist.minChunk, roundUpDiv(remaining, nWorkers)))
+			if atomic.CompareAndSwapInt64(&next, int64(begin), int64(begin+size)) {
+				return WorkAlloc{WorkerID: w.WorkerID, Begin: begin, End: begin + size}, true
+			}
+		}
+	}
+}
+
+// stolenChunks returns a function that hands out chunks of the minimum chunk
+// size from the front of every worker's own work. Once a worker runs out of
+// it, it steals the back half of another worker's.
+func (dist WorkDistribution) stolenChunks() func(WorkAlloc) (WorkAlloc, bool) {
+	deques := make([]deque, dist.NWorkers())
+	for i, w := range dist.Work {
+		deques[i].begin, deques[i].end = w.Begin, w.End
+	}
+
+	return func(w WorkAlloc) (WorkAlloc, bool) {
+		own := &deques[w.WorkerID]
+		for {
+			if begin, end, ok := own.popFront(dist.minChunk); ok {
+				return WorkAlloc{WorkerID: w.WorkerID, Begin: begin, End: end}, true
+			}
+			if !stealInto(own, deques, w.WorkerID) {
+				return w, false
+			}
+		}
+	}
+}
+
+// stealInto moves the back half of the work of the first non-empty deque
+// after the thief's into its own. It returns false if all of them are empty.
+func stealInto(own *deque, deques []deque, thief int) bool {
+	for i := 1; i < len(deques); i++ {
+		victim := &deques[(thief+i)%len(deques)]
+		if begin, end, ok := victim.popBackHalf(); ok {
+			own.push(begin, end)
+			return true
+		}
+	}
+	return false
+}
+
+// deque is the range of work [begin, end) owned by a worker. The owner takes
+// work from the front, and thieves take it from the back.
+type deque struct {
+	mu         sync.Mutex
+	begin, end int
+}
+
+// popFront removes up to size items from the front of the deque.
+func (d *deque) popFront(size int) (begin, end int, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.begin >= d.end {
+		return 0, 0, false
+	}
+	begin, end = d.begin, utils.Min(d.begin+size, d.end)
+	d.begin = end
+	return begin, end, true
+}
+
+// popBackHalf removes the back half of the deque, rounded up.
+func (d *deque) popBackHalf() (begin, end int, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.begin >= d.end {
+		return 0, 0, false
+	}
+	begin, end = d.begin+(d.end-d.begin)/2, d.end
+	d.end = begin
+	return begin, end, true
+}
+
+// push replaces the contents of an empty deque.
+func (d *deque) push(begin, end int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.begin, d.end = begin, end
+}
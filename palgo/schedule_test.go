@@ -0,0 +1,102 @@
+package palgo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedules(t *testing.T) {
+	t.Parallel()
+
+	schedules := []palgo.Schedule{palgo.Static, palgo.Dynamic, palgo.Guided, palgo.WorkStealing}
+
+	testCases := map[string]struct {
+		maxWorkers int
+		minChunk   int
+	}{
+		"single worker":      {maxWorkers: 1},
+		"default chunk":      {maxWorkers: 4},
+		"single-item chunks": {maxWorkers: 8, minChunk: 1},
+		"large chunks":       {maxWorkers: 4, minChunk: 100},
+	}
+
+	for _, schedule := range schedules {
+		for name, tc := range testCases {
+			tc := tc
+			exec := palgo.Executor{MaxWorkers: tc.maxWorkers, MinChunk: tc.minChunk, Schedule: schedule}
+			t.Run(fmt.Sprintf("%v, %s", schedule, name), func(t *testing.T) {
+				t.Parallel()
+
+				for _, size := range []int{0, 1, 7, 1000} {
+					rng := rand.New(rand.NewSource(int64(size))) //nolint: gosec // Deterministic tests.
+					input := algo.Generate(size, func() int { return rng.Intn(100) })
+					negate := func(x int) int { return -x }
+					even := func(x int) bool { return x%2 == 0 }
+
+					// Every item must be visited exactly once.
+					visits := make([]int, size)
+					palgo.ForeachWith(exec, visits, func(v *int) { *v++ })
+					require.Equal(t, algo.Generate(size, func() int { return 1 }), visits, "ForeachWith visited some items more or less than once")
+
+					require.Equal(t, algo.Map(input, negate), palgo.MapWith(exec, input, negate), "Wrong MapWith")
+					require.Equal(t, algo.ZipWith(input, input, utils.Add[int]), palgo.ZipWithWith(exec, input, input, utils.Add[int]), "Wrong ZipWithWith")
+					require.Equal(t, algo.FindIf(input, even), palgo.FindIfWith(exec, input, even), "Wrong FindIfWith")
+					require.Equal(t, algo.FindIf(input, even) >= 0, palgo.AnyWith(exec, input, even), "Wrong AnyWith")
+
+					got, err := palgo.MapErrWith(context.Background(), exec, input, func(x int) (int, error) { return -x, nil })
+					require.NoError(t, err, "MapErrWith should not fail")
+					require.Equal(t, algo.Map(input, negate), got, "Wrong MapErrWith")
+
+					if size == 0 {
+						continue
+					}
+
+					failing := errors.New("failed on purpose")
+					_, err = palgo.MapErrWith(context.Background(), exec, input, func(x int) (int, error) { return 0, failing })
+					require.ErrorIs(t, err, failing, "MapErrWith should have failed")
+				}
+			})
+		}
+	}
+}
+
+func TestScheduleString(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "Static", palgo.Static.String())
+	require.Equal(t, "WorkStealing", palgo.WorkStealing.String())
+	require.Equal(t, "Schedule(42)", palgo.Schedule(42).String())
+	require.Panics(t, func() {
+		palgo.ForeachWith(palgo.Executor{MaxWorkers: 4, MinChunk: 1, Schedule: 42}, make([]int, 10), func(*int) {})
+	}, "Unknown schedules should panic")
+}
+
+func BenchmarkSchedules(b *testing.B) {
+	const size = 1000
+
+	// The last tenth of the items is much more expensive than the rest.
+	input := algo.Generate(size, counter(0, 1))
+	work := func(x *int) {
+		if *x >= size*9/10 {
+			time.Sleep(10 * time.Microsecond)
+		}
+	}
+
+	for _, schedule := range []palgo.Schedule{palgo.Static, palgo.Dynamic, palgo.Guided, palgo.WorkStealing} {
+		exec := palgo.Executor{MaxWorkers: 8, MinChunk: 4, Schedule: schedule}
+		b.Run(schedule.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				palgo.ForeachWith(exec, input, work)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package palgo
+
+import (
+	"sync/atomic"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// FindIf traverses array arr searching for an element that makes
+// pred return true, and returns the lowest such index. If none match,
+// -1 is returned.
+//
+// Workers stop as soon as they reach an index past the lowest match
+// found so far, so the items after it are rarely visited.
+func FindIf[T any](arr []T, pred utils.Predicate[T]) int {
+	return FindIfWith(DefaultExecutor, arr, pred)
+}
+
+// FindIfWith is the same as FindIf, but it distributes the work with the given executor.
+func FindIfWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) int {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.FindIf(arr, pred)
+	}
+
+	best := int64(len(arr))
+	dist.mustRunChunks(func(w WorkAlloc) {
+		for j := w.Begin; j < w.End; j++ {
+			if int64(j) >= atomic.LoadInt64(&best) {
+				return // A match before this one has been found.
+			}
+			if pred(arr[j]) {
+				storeMin(&best, int64(j))
+				return
+			}
+		}
+	})
+
+	if best == int64(len(arr)) {
+		return -1
+	}
+	return int(best)
+}
+
+// FindAny traverses array arr searching for an element that makes
+// pred return true, and returns its index. If many match, there are
+// no guarantees as to which one is returned. If none match, -1 is
+// returned.
+//
+// Workers stop as soon as any of them finds a match, so it is faster
+// than FindIf when matches are abundant.
+func FindAny[T any](arr []T, pred utils.Predicate[T]) int {
+	return FindAnyWith(DefaultExecutor, arr, pred)
+}
+
+// FindAnyWith is the same as FindAny, but it distributes the work with the given executor.
+func FindAnyWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) int {
+	dist := exec.distribute(len(arr), 3)
+	if dist.NWorkers() < 2 {
+		return algo.FindIf(arr, pred)
+	}
+
+	found := int64(-1)
+	dist.mustRunChunks(func(w WorkAlloc) {
+		for j := w.Begin; j < w.End; j++ {
+			if atomic.LoadInt64(&found) >= 0 {
+				return // Another worker found a match.
+			}
+			if pred(arr[j]) {
+				atomic.CompareAndSwapInt64(&found, -1, int64(j))
+				return
+			}
+		}
+	})
+
+	return int(found)
+}
+
+// Any returns true if at least one item in arr fulfils the predicate.
+// Workers stop as soon as any of them finds one.
+func Any[T any](arr []T, pred utils.Predicate[T]) bool {
+	return AnyWith(DefaultExecutor, arr, pred)
+}
+
+// AnyWith is the same as Any, but it distributes the work with the given executor.
+func AnyWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) bool {
+	return FindAnyWith(exec, arr, pred) >= 0
+}
+
+// All returns true if every item in arr fulfils the predicate, which is
+// always the case for empty arrays. Workers stop as soon as any of them
+// finds an item that does not.
+func All[T any](arr []T, pred utils.Predicate[T]) bool {
+	return AllWith(DefaultExecutor, arr, pred)
+}
+
+// AllWith is the same as All, but it distributes the work with the given executor.
+func AllWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) bool {
+	return FindAnyWith(exec, arr, func(t T) bool { return !pred(t) }) < 0
+}
+
+// None returns true if no item in arr fulfils the predicate. Workers stop
+// as soon as any of them finds one that does.
+func None[T any](arr []T, pred utils.Predicate[T]) bool {
+	return NoneWith(DefaultExecutor, arr, pred)
+}
+
+// NoneWith is the same as None, but it distributes the work with the given executor.
+func NoneWith[T any](exec Executor, arr []T, pred utils.Predicate[T]) bool {
+	return !AnyWith(exec, arr, pred)
+}
+
+// storeMin atomically stores val into addr if it is smaller than the
+// value already there.
+func storeMin(addr *int64, val int64) {
+	for {
+		old := atomic.LoadInt64(addr)
+		if val >= old || atomic.CompareAndSwapInt64(addr, old, val) {
+			return
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package palgo_test
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testSearch[int])
+	t.Run("int8", testSearch[int8])
+	t.Run("int32", testSearch[int32])
+	t.Run("int64", testSearch[int64])
+}
+
+func TestSearchStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	const size = 1_000_000
+
+	// Every search stops at the first item, which is the first of its worker.
+	exec := palgo.Executor{MaxWorkers: 4, MinChunk: 1}
+
+	testCases := map[string]struct {
+		search func(exec palgo.Executor, arr []int, pred utils.Predicate[int]) bool
+		match  func(i int) bool
+		want   bool
+	}{
+		"FindIf, first":      {search: func(e palgo.Executor, a []int, p utils.Predicate[int]) bool { return palgo.FindIfWith(e, a, p) == 0 }, match: func(i int) bool { return i == 0 }, want: true},
+		"FindIf, every item": {search: func(e palgo.Executor, a []int, p utils.Predicate[int]) bool { return palgo.FindIfWith(e, a, p) == 0 }, match: func(i int) bool { return true }, want: true},
+		"FindAny":            {search: func(e palgo.Executor, a []int, p utils.Predicate[int]) bool { return palgo.FindAnyWith(e, a, p) >= 0 }, match: func(i int) bool { return i == 0 }, want: true},
+		"Any":                {search: palgo.AnyWith[int], match: func(i int) bool { return i == 0 }, want: true},
+		"All":                {search: palgo.AllWith[int], match: func(i int) bool { return i != 0 }, want: false},
+		"None":               {search: palgo.NoneWith[int], match: func(i int) bool { return i == 0 }, want: false},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arr := algo.Generate(size, counter(0, 1))
+
+			// The rest of the workers are held back until the first item has
+			// been checked, so they cannot get through their chunks before it.
+			var calls int64
+			first := make(chan struct{})
+			pred := func(i int) bool {
+				atomic.AddInt64(&calls, 1)
+				if i == 0 {
+					defer close(first)
+				} else {
+					<-first
+				}
+				return tc.match(i)
+			}
+
+			require.Equal(t, tc.want, tc.search(exec, arr, pred), "Wrong result")
+			require.Less(t, atomic.LoadInt64(&calls), int64(size/2), "Search should have stopped early")
+		})
+	}
+}
+
+func testSearch[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size int
+		pred utils.Predicate[T]
+	}{
+		"empty":         {size: 0, pred: func(x T) bool { return x == 50 }},
+		"small, match":  {size: 10, pred: func(x T) bool { return x > 50 }},
+		"small, none":   {size: 10, pred: func(x T) bool { return x < 0 }},
+		"large, common": {size: 100_000, pred: func(x T) bool { return x > 50 }},
+		"large, rare":   {size: 100_000, pred: func(x T) bool { return x == 99 }},
+		"large, none":   {size: 100_000, pred: func(x T) bool { return x >= 100 }},
+		"large, all":    {size: 100_000, pred: func(x T) bool { return x >= 0 }},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			input := algo.Generate(tc.size, func() T { return T(rng.Intn(100)) })
+
+			want := algo.FindIf(input, tc.pred)
+			require.Equal(t, want, palgo.FindIf(input, tc.pred), "Wrong FindIf")
+
+			anyIdx := palgo.FindAny(input, tc.pred)
+			if want < 0 {
+				require.Equal(t, -1, anyIdx, "FindAny should have found nothing")
+			} else {
+				require.True(t, tc.pred(input[anyIdx]), "FindAny returned a non-matching item")
+			}
+
+			require.Equal(t, want >= 0, palgo.Any(input, tc.pred), "Wrong Any")
+			require.Equal(t, want < 0, palgo.None(input, tc.pred), "Wrong None")
+
+			all := algo.FindIf(input, func(x T) bool { return !tc.pred(x) }) < 0
+			require.Equal(t, all, palgo.All(input, tc.pred), "Wrong All")
+		})
+	}
+}
+
+// counter returns a generator of the sequence start, start+step, start+2·step, ...
+func counter(start, step int) func() int {
+	next := start
+	return func() int {
+		defer func() { next += step }()
+		return next
+	}
+}
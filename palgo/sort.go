@@ -0,0 +1,211 @@
+package palgo
+
+import (
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/internal/inplace"
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+const (
+	// sortMinWorkload is the shortest chunk worth sorting in its own goroutine.
+	sortMinWorkload = 1 << 13
+
+	// mergeMinWorkload is the shortest output chunk worth merging in its own goroutine.
+	mergeMinWorkload = 1 << 13
+
+	// sortBlockSize is the number of items that Sort sorts sequentially, before
+	// merging the results.
+	sortBlockSize = 1 << 14
+)
+
+// Sort sorts a list according to a comparator comp. Item i preceedes
+// item j <=> comp(i,j) is true.
+//
+// Unlike a plain merge sort, the result only depends on the list: it is
+// split into blocks of a fixed size, which are sorted concurrently with
+// algo.Sort and then merged in parallel. The merge is stable, so equivalent
+// items from earlier blocks preceed those from later blocks. Hence, the order
+// of equivalent items is the same regardless of the number of workers or the
+// schedule, and lists that fit in a single block are sorted exactly like
+// algo.Sort does.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr|·log(|arr|)).
+func Sort[T any](arr []T, comp utils.Comparator[T]) {
+	SortWith(DefaultExecutor, arr, comp)
+}
+
+// SortWith is the same as Sort, but it distributes the work with the given executor.
+func SortWith[T any](exec Executor, arr []T, comp utils.Comparator[T]) {
+	if len(arr) <= sortBlockSize {
+		algo.Sort(arr, comp)
+		return
+	}
+
+	// The executor splits the blocks, not the items.
+	nBlocks := roundUpDiv(len(arr), sortBlockSize)
+	exec.MinChunk = roundUpDiv(exec.MinChunk, sortBlockSize)
+
+	bounds := make([]int, nBlocks+1)
+	for b := range bounds {
+		bounds[b] = utils.Min(b*sortBlockSize, len(arr))
+	}
+
+	exec.distribute(nBlocks, 1).mustRunChunks(func(w WorkAlloc) {
+		for b := w.Begin; b < w.End; b++ {
+			algo.Sort(arr[bounds[b]:bounds[b+1]], comp)
+		}
+	})
+
+	mergeRuns(exec, arr, bounds, comp)
+}
+
+// StableSort sorts a list according to a comparator comp, like Sort. Unlike
+// Sort, equivalent items keep their original relative order.
+//
+// The list is split into chunks that are sorted concurrently with
+// algo.StableSort, and then merged in parallel.
+//
+// It needs O(|arr|) additional memory.
+//
+// Complexity is O(|arr|·log²(|arr|)).
+func StableSort[T any](arr []T, comp utils.Comparator[T]) {
+	StableSortWith(DefaultExecutor, arr, comp)
+}
+
+// StableSortWith is the same as StableSort, but it distributes the work with the given executor.
+func StableSortWith[T any](exec Executor, arr []T, comp utils.Comparator[T]) {
+	mergeSort(exec, arr, comp)
+}
+
+// Merge combines two sorted slices into a single sorted slice with all
+// their elements.
+//
+// The lists are expected to have been sorted with the comparator 'comp'.
+// The merge is stable: equivalent items keep their relative order, and
+// those from the first list preceed those from the second one.
+//
+// Complexity is O(|first| + |second|).
+func Merge[T any](first, second []T, comp utils.Comparator[T]) []T {
+	return MergeWith(DefaultExecutor, first, second, comp)
+}
+
+// MergeWith is the same as Merge, but it distributes the work with the given executor.
+func MergeWith[T any](exec Executor, first, second []T, comp utils.Comparator[T]) []T {
+	out := make([]T, len(first)+len(second))
+	merge(exec, out, first, second, comp)
+	return out
+}
+
+// MergeAll is the same as Merge, but it combines any number of lists. Out of
+// equivalent items, those in earlier lists preceed those in later lists.
+//
+// The lists are merged pairwise, in log(k) rounds of parallel merges.
+//
+// It needs O(n) additional memory.
+//
+// Complexity is O(n·log(k)), where n is the total number of items and k is
+// the number of lists.
+func MergeAll[T any](lists [][]T, comp utils.Comparator[T]) []T {
+	return MergeAllWith(DefaultExecutor, lists, comp)
+}
+
+// MergeAllWith is the same as MergeAll, but it distributes the work with the given executor.
+func MergeAllWith[T any](exec Executor, lists [][]T, comp utils.Comparator[T]) []T {
+	bounds := make([]int, len(lists)+1)
+	for i, l := range lists {
+		bounds[i+1] = bounds[i] + len(l)
+	}
+
+	out := make([]T, bounds[len(lists)])
+	for i, l := range lists {
+		copy(out[bounds[i]:], l)
+	}
+	if len(lists) < 2 {
+		return out
+	}
+
+	mergeRuns(exec, out, bounds, comp)
+	return out
+}
+
+// mergeSort sorts the list stably by sorting chunks concurrently, and
+// merging them.
+func mergeSort[T any](exec Executor, arr []T, comp utils.Comparator[T]) {
+	dist := exec.distribute(len(arr), sortMinWorkload)
+	if dist.NWorkers() < 2 {
+		algo.StableSort(arr, comp)
+		return
+	}
+
+	dist.Run(func(w WorkAlloc) {
+		algo.StableSort(arr[w.Begin:w.End], comp)
+	})
+
+	bounds := make([]int, 0, dist.NWorkers()+1)
+	for _, w := range dist.Work {
+		bounds = append(bounds, w.Begin)
+	}
+	bounds = append(bounds, len(arr))
+
+	mergeRuns(exec, arr, bounds, comp)
+}
+
+// mergeRuns merges the sorted runs arr[bounds[i]:bounds[i+1]] into a single
+// sorted list. Adjacent runs are merged pairwise, alternating between arr and
+// a buffer of the same length.
+func mergeRuns[T any](exec Executor, arr []T, bounds []int, comp utils.Comparator[T]) {
+	src, dst := arr, make([]T, len(arr))
+	for len(bounds) > 2 {
+		next := make([]int, 0, len(bounds)/2+1)
+		for i := 0; i+1 < len(bounds); i += 2 {
+			lo, mid := bounds[i], bounds[i+1]
+			next = append(next, lo)
+			if i+2 == len(bounds) {
+				copy(dst[lo:mid], src[lo:mid]) // Odd run out.
+				continue
+			}
+			hi := bounds[i+2]
+			merge(exec, dst[lo:hi], src[lo:mid], src[mid:hi], comp)
+		}
+		bounds = append(next, bounds[len(bounds)-1])
+		src, dst = dst, src
+	}
+	if len(src) > 0 && &src[0] != &arr[0] {
+		copy(arr, src)
+	}
+}
+
+// merge combines two sorted slices into dst, with the output split into
+// chunks that are merged concurrently.
+func merge[T any](exec Executor, dst, first, second []T, comp utils.Comparator[T]) {
+	dist := exec.distribute(len(dst), mergeMinWorkload)
+	if dist.NWorkers() < 2 {
+		inplace.Merge(dst, first, second, comp)
+		return
+	}
+
+	dist.Run(func(w WorkAlloc) {
+		f0 := coRank(w.Begin, first, second, comp)
+		f1 := coRank(w.End, first, second, comp)
+		inplace.Merge(dst[w.Begin:w.End], first[f0:f1], second[w.Begin-f0:w.End-f1], comp)
+	})
+}
+
+// coRank finds how many of the first k items of the stable merge of first
+// and second come from the first list.
+//
+// Complexity is O(log(k)).
+func coRank[T any](k int, first, second []T, comp utils.Comparator[T]) int {
+	lo := utils.Max(0, k-len(second))
+	hi := utils.Min(k, len(first))
+
+	// Taking f items from the first list is too few if the next one does not
+	// succeed the last one taken from the second list.
+	return lo + algo.BinarySearchFunc(hi-lo, func(i int) bool {
+		f := lo + i
+		s := k - f
+		return s > 0 && !comp(second[s-1], first[f])
+	})
+}
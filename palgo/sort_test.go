@@ -0,0 +1,199 @@
+package palgo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+	t.Run("int", testSort[int])
+	t.Run("int8", testSort[int8])
+	t.Run("int32", testSort[int32])
+	t.Run("int64", testSort[int64])
+	t.Run("float64", testSort[float64])
+}
+
+func TestSortEquivalentItems(t *testing.T) {
+	t.Parallel()
+
+	// Items are sorted by key only, so the origin tells them apart.
+	type item struct{ key, origin int }
+	comp := func(a, b item) bool { return a.key < b.key }
+
+	// The same as in palgo.Sort.
+	const blockSize = 1 << 14
+
+	executors := testExecutors()
+	executors["single worker"] = palgo.Executor{MaxWorkers: 1}
+	executors["dynamic"] = palgo.Executor{MaxWorkers: 8, MinChunk: 1, Schedule: palgo.Dynamic}
+
+	for _, size := range []int{100, blockSize, 100_000} {
+		rng := rand.New(rand.NewSource(int64(size))) //nolint: gosec // Deterministic tests.
+		input := make([]item, size)
+		for i := range input {
+			input[i] = item{key: rng.Intn(50), origin: i}
+		}
+
+		// Every block is sorted like algo.Sort does, and then they are merged stably.
+		blocks := algo.Chunk(append([]item{}, input...), blockSize)
+		for _, b := range blocks {
+			algo.Sort(b, comp)
+		}
+		want := algo.MergeAll(blocks, comp)
+
+		if size <= blockSize {
+			sorted := append([]item{}, input...)
+			algo.Sort(sorted, comp)
+			require.Equal(t, sorted, want, "Lists that fit in a block should be sorted like algo.Sort does")
+		}
+
+		for name, exec := range executors {
+			t.Run(fmt.Sprintf("%s, %d", name, size), func(t *testing.T) {
+				t.Parallel()
+
+				got := append([]item{}, input...)
+				palgo.SortWith(exec, got, comp)
+				require.Equal(t, want, got, "Equivalent items should be in the same order regardless of the executor")
+			})
+		}
+	}
+}
+
+func TestStableSort(t *testing.T) {
+	t.Parallel()
+
+	// Items are sorted by key only, so the origin tells them apart.
+	type item struct{ key, origin int }
+	comp := func(a, b item) bool { return a.key < b.key }
+
+	for _, size := range []int{0, 1, 100, 10_000, 100_000} {
+		size := size
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(int64(size))) //nolint: gosec // Deterministic tests.
+			input := make([]item, size)
+			for i := range input {
+				input[i] = item{key: rng.Intn(50), origin: i}
+			}
+
+			want := append([]item{}, input...)
+			algo.StableSort(want, comp)
+
+			got := append([]item{}, input...)
+			palgo.StableSort(got, comp)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	type item struct{ key, origin int }
+	comp := func(a, b item) bool { return a.key < b.key }
+
+	testCases := map[string]struct {
+		sizes []int
+	}{
+		"none":        {sizes: []int{}},
+		"one":         {sizes: []int{100}},
+		"two empty":   {sizes: []int{0, 0}},
+		"two small":   {sizes: []int{10, 7}},
+		"two large":   {sizes: []int{60_000, 40_000}},
+		"unbalanced":  {sizes: []int{100_000, 5}},
+		"three":       {sizes: []int{30_000, 0, 30_000}},
+		"many":        {sizes: []int{20_000, 1, 15_000, 30_000, 0, 7, 25_000}},
+		"many sorted": {sizes: []int{1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			lists := make([][]item, len(tc.sizes))
+			for i, size := range tc.sizes {
+				lists[i] = make([]item, size)
+				for j := range lists[i] {
+					lists[i][j] = item{key: rng.Intn(1000), origin: i}
+				}
+				algo.StableSort(lists[i], comp)
+			}
+
+			require.Equal(t, algo.MergeAll(lists, comp), palgo.MergeAll(lists, comp), "Wrong k-way merge")
+			if len(lists) == 2 {
+				require.Equal(t, algo.Merge(lists[0], lists[1], comp), palgo.Merge(lists[0], lists[1], comp), "Wrong merge")
+			}
+		})
+	}
+}
+
+func testSort[T utils.Signed](t *testing.T) { //nolint: thelper
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size     int
+		maxValue int
+		comp     utils.Comparator[T]
+	}{
+		"empty":              {size: 0, maxValue: 10, comp: utils.Lt[T]},
+		"single":             {size: 1, maxValue: 10, comp: utils.Lt[T]},
+		"small":              {size: 100, maxValue: 100, comp: utils.Lt[T]},
+		"large":              {size: 100_000, maxValue: 100, comp: utils.Lt[T]},
+		"large decreasing":   {size: 100_000, maxValue: 100, comp: utils.Gt[T]},
+		"large, odd length":  {size: 123_457, maxValue: 100, comp: utils.Lt[T]},
+		"large, all equal":   {size: 50_000, maxValue: 1, comp: utils.Lt[T]},
+		"large, few repeats": {size: 50_000, maxValue: 127, comp: utils.Gt[T]},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+			input := algo.Generate(tc.size, func() T { return T(rng.Intn(tc.maxValue)) })
+
+			want := append([]T{}, input...)
+			algo.Sort(want, tc.comp)
+
+			got := append([]T{}, input...)
+			palgo.Sort(got, tc.comp)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	const size = 10_000_000
+
+	rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+	input := algo.Generate(size, rng.Int)
+	arr := make([]int, size)
+
+	sorts := map[string]func([]int, utils.Comparator[int]){
+		"algo.Sort":        algo.Sort[int],
+		"palgo.Sort":       palgo.Sort[int],
+		"algo.StableSort":  algo.StableSort[int],
+		"palgo.StableSort": palgo.StableSort[int],
+	}
+
+	for name, sort := range sorts {
+		sort := sort
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				sort(arr, utils.Lt[int])
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package palgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The functions in this file process items that arrive over channels. Every
+// one of them launches goroutines that stop once their input is closed, or
+// once the context is cancelled. Then, they close their output. Channels are
+// bounded, so a slow consumer slows down the stages before it.
+
+// MapChan applies function f:T->O concurrently to the items received from in,
+// and sends the results to the returned channel. The results are sent as soon
+// as they are ready, so they may come out of order. See MapChanOrdered for an
+// order-preserving version.
+func MapChan[T, O any](ctx context.Context, in <-chan T, f func(T) O) <-chan O {
+	return MapChanWith(ctx, DefaultExecutor, in, f)
+}
+
+// MapChanWith is the same as MapChan, but it launches as many workers as the
+// executor's MaxWorkers. The rest of the executor's settings are ignored.
+func MapChanWith[T, O any](ctx context.Context, exec Executor, in <-chan T, f func(T) O) <-chan O {
+	n := exec.maxWorkers()
+	out := make(chan O, n)
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				t, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, f(t)) {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// MapChanOrdered is the same as MapChan, except that the results are sent in
+// the same order as the items were received. Results that are ready early are
+// held in a reorder buffer until all the ones before them are sent.
+func MapChanOrdered[T, O any](ctx context.Context, in <-chan T, f func(T) O) <-chan O {
+	return MapChanOrderedWith(ctx, DefaultExecutor, in, f)
+}
+
+// MapChanOrderedWith is the same as MapChanOrdered, but it launches as many
+// workers as the executor's MaxWorkers. The rest of the executor's settings
+// are ignored.
+//
+// At most twice as many items as workers are processed or held in the reorder
+// buffer at any time, so a slow item stops the intake of new ones instead of
+// letting the buffer grow.
+func MapChanOrderedWith[T, O any](ctx context.Context, exec Executor, in <-chan T, f func(T) O) <-chan O {
+	n := exec.maxWorkers()
+
+	// Every item takes a slot when it is received, and releases it when its
+	// result is sent.
+	slots := make(chan struct{}, 2*n)
+
+	tasks := make(chan indexed[T])
+	go func() {
+		defer close(tasks)
+		for i := 0; ; i++ {
+			if !send(ctx, slots, struct{}{}) {
+				return
+			}
+			t, ok := receive(ctx, in)
+			if !ok || !send(ctx, tasks, indexed[T]{index: i, value: t}) {
+				return
+			}
+		}
+	}()
+
+	results := make(chan indexed[O], n)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			for task := range tasks {
+				if !send(ctx, results, indexed[O]{index: task.index, value: f(task.value)}) {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	out := make(chan O, n)
+	go func() {
+		defer close(out)
+
+		pending := make(map[int]O)
+		next := 0
+		for r := range results {
+			pending[r.index] = r.value
+			for {
+				o, ok := pending[next]
+				if !ok {
+					break
+				}
+				if !send(ctx, out, o) {
+					return
+				}
+				delete(pending, next)
+				<-slots
+				next++
+			}
+		}
+	}()
+
+	return out
+}
+
+// Batch groups the items received from in into slices of the given size,
+// and sends them to the returned channel. A shorter batch is sent when the
+// input is closed, or when maxWait has passed since the first item in the
+// batch was received. If maxWait is not positive, batches are never sent
+// early. It panics if size is not positive.
+func Batch[T any](ctx context.Context, in <-chan T, size int, maxWait time.Duration) <-chan []T {
+	if size <= 0 {
+		panic("batch size must be positive")
+	}
+
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+
+		batch := make([]T, 0, size)
+		var timeout <-chan time.Time
+		flush := func() bool {
+			if len(batch) == 0 {
+				return true
+			}
+			ok := send(ctx, out, batch)
+			batch = make([]T, 0, size)
+			timeout = nil
+			return ok
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			case t, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 && maxWait > 0 {
+					timeout = time.After(maxWait)
+				}
+				batch = append(batch, t)
+				if len(batch) == size && !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// FanOut splits the items received from in among n channels. Every item is
+// sent to only one of them, whichever is ready to receive it first. It panics
+// if n is not positive.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		panic("fan-out needs at least one output")
+	}
+
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			forward(ctx, out, in)
+		}()
+	}
+	return outs
+}
+
+// FanIn sends the items received from all the input channels to a single
+// one. Items from the same input keep their order, but they are interleaved
+// with the rest. The output is closed once all inputs are closed.
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func() {
+			defer wg.Done()
+			forward(ctx, out, in)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Buffer forwards the items received from in, holding up to size of them
+// while the consumer is busy. Once the buffer is full, it stops receiving
+// until the consumer catches up. It panics if size is negative.
+func Buffer[T any](ctx context.Context, in <-chan T, size int) <-chan T {
+	if size < 0 {
+		panic("buffer size must not be negative")
+	}
+
+	out := make(chan T, size)
+	go func() {
+		defer close(out)
+		forward(ctx, out, in)
+	}()
+	return out
+}
+
+// indexed is an item tagged with its position in a stream.
+type indexed[T any] struct {
+	index int
+	value T
+}
+
+// forward sends every item received from in to out, until in is closed or
+// the context is cancelled.
+func forward[T any](ctx context.Context, out chan<- T, in <-chan T) {
+	for {
+		t, ok := receive(ctx, in)
+		if !ok || !send(ctx, out, t) {
+			return
+		}
+	}
+}
+
+// receive returns the next item in the channel. The boolean is false if the
+// channel is closed or the context is cancelled.
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	case t, ok := <-in:
+		return t, ok
+	}
+}
+
+// send sends the item to the channel. It returns false if the context is
+// cancelled before it can be sent.
+func send[T any](ctx context.Context, out chan<- T, t T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case out <- t:
+		return true
+	}
+}
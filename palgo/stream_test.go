@@ -0,0 +1,248 @@
+package palgo_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapChan(t *testing.T) {
+	t.Parallel()
+
+	for _, workers := range []int{0, 1, 4, 16} {
+		for _, size := range []int{0, 1, 500} {
+			exec := palgo.Executor{MaxWorkers: workers}
+			t.Run(fmt.Sprintf("%d workers, %d items", workers, size), func(t *testing.T) {
+				t.Parallel()
+
+				ctx := testContext(t)
+
+				input := algo.Generate(size, counter(0, 1))
+
+				// Delaying a few items makes results come out of order.
+				rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+				delayed := algo.Generate(size, func() bool { return rng.Intn(20) == 0 })
+				square := func(x int) int {
+					if delayed[x] {
+						time.Sleep(time.Millisecond)
+					}
+					return x * x
+				}
+				want := algo.Map(input, square)
+
+				got := drain(palgo.MapChanOrderedWith(ctx, exec, feed(ctx, input), square))
+				require.Equal(t, want, got, "MapChanOrderedWith should keep the input order")
+
+				got = drain(palgo.MapChanWith(ctx, exec, feed(ctx, input), square))
+				algo.Sort(got, utils.Lt[int])
+				require.Equal(t, want, got, "MapChanWith should output every result once")
+			})
+		}
+	}
+}
+
+func TestMapChanCancel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(context.Context, <-chan int, func(int) int) <-chan int{
+		"MapChan":        palgo.MapChan[int, int],
+		"MapChanOrdered": palgo.MapChanOrdered[int, int],
+	}
+
+	for name, mapChan := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// Infinite input.
+			in := make(chan int)
+			go func() {
+				for i := 0; ; i++ {
+					select {
+					case <-ctx.Done():
+						return
+					case in <- i:
+					}
+				}
+			}()
+
+			out := mapChan(ctx, in, func(x int) int { return x })
+			for i := 0; i < 10; i++ {
+				<-out
+			}
+			cancel()
+
+			// The output must be closed after cancelling, even if nobody reads it.
+			require.Eventually(t, func() bool {
+				for {
+					select {
+					case _, ok := <-out:
+						if !ok {
+							return true
+						}
+					default:
+						return false
+					}
+				}
+			}, time.Second, time.Millisecond, "Output was not closed after cancelling")
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size  int
+		input []int
+		want  [][]int
+	}{
+		"empty":        {size: 3, input: []int{}, want: [][]int{}},
+		"exact":        {size: 2, input: []int{1, 2, 3, 4}, want: [][]int{{1, 2}, {3, 4}}},
+		"last partial": {size: 3, input: []int{1, 2, 3, 4}, want: [][]int{{1, 2, 3}, {4}}},
+		"single items": {size: 1, input: []int{1, 2}, want: [][]int{{1}, {2}}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testContext(t)
+			require.Equal(t, tc.want, drain(palgo.Batch(ctx, feed(ctx, tc.input), tc.size, 0)))
+			require.Equal(t, tc.want, drain(palgo.Batch(ctx, feed(ctx, tc.input), tc.size, time.Hour)))
+		})
+	}
+
+	ctx := testContext(t)
+	require.Panics(t, func() { palgo.Batch(ctx, feed(ctx, []int{}), 0, 0) })
+}
+
+func TestBatchMaxWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := testContext(t)
+
+	// The input is never closed, so only the maximum wait can send the batch.
+	in := make(chan int)
+	go func() {
+		for _, x := range []int{1, 2} {
+			select {
+			case <-ctx.Done():
+				return
+			case in <- x:
+			}
+		}
+	}()
+
+	out := palgo.Batch(ctx, in, 100, 10*time.Millisecond)
+
+	select {
+	case batch := <-out:
+		// The second item may miss the batch if the sender is descheduled.
+		require.NotEmpty(t, batch, "Batch should not send empty batches")
+		require.LessOrEqual(t, len(batch), 2, "Wrong early batch")
+		require.Equal(t, []int{1, 2}[:len(batch)], batch, "Wrong early batch")
+	case <-time.After(time.Second):
+		require.Fail(t, "Batch should have been sent after the maximum wait")
+	}
+}
+
+func TestFanOutFanIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := testContext(t)
+	input := algo.Generate(1000, counter(0, 1))
+
+	outs := palgo.FanOut(ctx, feed(ctx, input), 5)
+	require.Len(t, outs, 5)
+
+	// Every branch processes its items separately.
+	branches := algo.Map(outs, func(ch <-chan int) <-chan int {
+		return palgo.MapChan(ctx, ch, func(x int) int { return -x })
+	})
+
+	got := drain(palgo.FanIn(ctx, branches...))
+	algo.Sort(got, utils.Gt[int])
+	require.Equal(t, algo.Map(input, func(x int) int { return -x }), got)
+
+	require.Empty(t, drain(palgo.FanIn[int](ctx)), "FanIn of nothing should be empty")
+	require.Panics(t, func() { palgo.FanOut(ctx, feed(ctx, input), 0) })
+}
+
+func TestBuffer(t *testing.T) {
+	t.Parallel()
+
+	const size = 3
+
+	ctx := testContext(t)
+
+	var sent int64
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 0; i < 100; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case in <- i:
+			}
+			atomic.AddInt64(&sent, 1)
+		}
+	}()
+
+	out := palgo.Buffer(ctx, in, size)
+
+	// Nobody reads, so the producer is blocked once the buffer is full. One more
+	// item is held by the goroutine forwarding them.
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&sent) == size+1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, int64(size+1), atomic.LoadInt64(&sent), "Buffer should apply backpressure")
+
+	require.Equal(t, algo.Generate(100, counter(0, 1)), drain(out))
+	require.Panics(t, func() { palgo.Buffer(ctx, in, -1) })
+}
+
+// feed returns a channel that receives the items and is then closed. It
+// stops early if the context is cancelled.
+func feed[T any](ctx context.Context, items []T) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for _, t := range items {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- t:
+			}
+		}
+	}()
+	return ch
+}
+
+// testContext returns a context that is cancelled when the test ends, so that
+// no stage outlives it.
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// drain receives all items from a channel until it is closed.
+func drain[T any](ch <-chan T) []T {
+	out := []T{}
+	for t := range ch {
+		out = append(out, t)
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+package palgo
+
+import (
+	"fmt"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// Sum adds up all the items in arr with the chosen algorithm. See
+// algo.SumMode for their accuracy. Every chunk is added up on its own, and
+// then the partial sums are combined with the same algorithm.
+//
+// Complexity is O(|arr|).
+func Sum[T constraints.Float](arr []T, mode algo.SumMode) T {
+	return SumWith(DefaultExecutor, arr, mode)
+}
+
+// SumWith is the same as Sum, but it distributes the work with the given executor.
+func SumWith[T constraints.Float](exec Executor, arr []T, mode algo.SumMode) T {
+	switch mode {
+	case algo.Naive:
+		return ReduceWith(exec, arr, utils.Add[T], 0)
+	case algo.Pairwise:
+		dist := exec.distribute(len(arr), 3)
+		partial := make([]T, dist.NWorkers())
+		dist.Run(func(w WorkAlloc) {
+			partial[w.WorkerID] = algo.Sum(arr[w.Begin:w.End], algo.Pairwise)
+		})
+		return algo.Sum(partial, algo.Pairwise)
+	case algo.Neumaier:
+		unary := func(x T) algo.NeumaierSum[T] { return algo.NeumaierSum[T]{}.Add(x) }
+		return MapReduceWith(exec, arr, unary, algo.NeumaierSum[T].Merge, algo.NeumaierSum[T]{}).Value()
+	}
+	panic(fmt.Errorf("unknown sum mode: %d", mode))
+}
+
+// Dot computes the inner product of u and v, with the products and their
+// sums compensated for rounding error. Items past the length of the shortest
+// input are ignored.
+//
+// Complexity is O(min(|u|, |v|)).
+func Dot[T constraints.Float](u, v []T) T {
+	return DotWith(DefaultExecutor, u, v)
+}
+
+// DotWith is the same as Dot, but it distributes the work with the given executor.
+func DotWith[T constraints.Float](exec Executor, u, v []T) T {
+	return ZipReduceWith(exec, u, v, algo.NewProduct[T], algo.NeumaierSum[T].Merge, algo.NeumaierSum[T]{}).Value()
+}
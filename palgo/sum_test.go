@@ -0,0 +1,108 @@
+package palgo_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	executors := testExecutors()
+
+	testCases := map[string]struct {
+		size int
+		gen  func(*rand.Rand) float64
+	}{
+		"empty":             {size: 0, gen: (*rand.Rand).Float64},
+		"single":            {size: 1, gen: (*rand.Rand).Float64},
+		"growing magnitude": {size: 100_000, gen: func(rng *rand.Rand) float64 { return rng.Float64() * math.Pow(10, float64(rng.Intn(12))) }},
+		"mixed signs":       {size: 100_000, gen: func(rng *rand.Rand) float64 { return rng.NormFloat64() * 1e6 }},
+	}
+
+	for name, tc := range testCases {
+		for execName, exec := range executors {
+			tc := tc
+			exec := exec
+			t.Run(name+", "+execName, func(t *testing.T) {
+				t.Parallel()
+
+				rng := rand.New(rand.NewSource(42)) //nolint: gosec // Deterministic tests.
+				u := algo.Generate(tc.size, func() float64 { return tc.gen(rng) })
+				v := algo.Generate(tc.size, func() float64 { return tc.gen(rng) })
+
+				ref, absSum := exactSum(u)
+				eps := math.Nextafter(1, 2) - 1
+				n := float64(tc.size)
+
+				neumaier := palgo.SumWith(exec, u, algo.Neumaier)
+				pairwise := palgo.SumWith(exec, u, algo.Pairwise)
+				naive := palgo.SumWith(exec, u, algo.Naive)
+
+				require.LessOrEqual(t, math.Abs(neumaier-ref), 2*eps*math.Abs(ref), "Neumaier summation is not accurate enough: got %g, want %g", neumaier, ref)
+				require.LessOrEqual(t, math.Abs(pairwise-ref), eps*math.Ceil(math.Log2(n+1)+1)*absSum, "Pairwise summation is not accurate enough: got %g, want %g", pairwise, ref)
+				require.LessOrEqual(t, math.Abs(naive-ref), eps*n*absSum, "Naive summation is not accurate enough: got %g, want %g", naive, ref)
+
+				ref, _ = exactDot(u, v)
+				dot := palgo.DotWith(exec, u, v)
+				require.LessOrEqual(t, math.Abs(dot-ref), 2*eps*math.Abs(ref), "Dot product is not accurate enough: got %g, want %g", dot, ref)
+			})
+		}
+	}
+}
+
+func TestSumNonFinite(t *testing.T) {
+	t.Parallel()
+
+	exec := palgo.Executor{MaxWorkers: 8, MinChunk: 1}
+	modes := map[string]algo.SumMode{"Naive": algo.Naive, "Pairwise": algo.Pairwise, "Neumaier": algo.Neumaier}
+
+	// The special values end up in a different chunk than most of the items.
+	withAt := func(i int, x float64) []float64 {
+		arr := algo.Generate(1000, func() float64 { return 1 })
+		arr[i] = x
+		return arr
+	}
+
+	inf := math.Inf(1)
+	for modeName, mode := range modes {
+		require.Equal(t, inf, palgo.SumWith(exec, withAt(500, inf), mode), "Wrong %s sum with an infinity", modeName)
+		require.Equal(t, -inf, palgo.SumWith(exec, withAt(0, -inf), mode), "Wrong %s sum with an infinity", modeName)
+		require.True(t, math.IsNaN(palgo.SumWith(exec, withAt(999, math.NaN()), mode)), "Wrong %s sum with a NaN", modeName)
+
+		opposite := withAt(0, inf)
+		opposite[999] = -inf
+		require.True(t, math.IsNaN(palgo.SumWith(exec, opposite, mode)), "Wrong %s sum of opposite infinities", modeName)
+	}
+
+	require.Equal(t, inf, palgo.DotWith(exec, withAt(500, inf), withAt(0, 2)), "Wrong dot product with an infinity")
+	require.True(t, math.IsNaN(palgo.DotWith(exec, withAt(500, inf), withAt(500, 0))), "Wrong dot product of an infinity and zero")
+}
+
+// exactSum returns the sum of the input rounded to float64, and the sum of its
+// absolute values, computed with enough precision to be exact.
+func exactSum(arr []float64) (sum, absSum float64) {
+	return exactDot(arr, algo.Generate(len(arr), func() float64 { return 1 }))
+}
+
+// exactDot returns the inner product of the inputs rounded to float64, and the
+// sum of the absolute values of the products, computed with enough precision
+// to be exact.
+func exactDot(u, v []float64) (dot, absDot float64) {
+	s := new(big.Float).SetPrec(4096)
+	a := new(big.Float).SetPrec(4096)
+	for i := range u {
+		p := new(big.Float).SetPrec(4096).Mul(big.NewFloat(u[i]), big.NewFloat(v[i]))
+		s.Add(s, p)
+		a.Add(a, p.Abs(p))
+	}
+	dot, _ = s.Float64()
+	absDot, _ = a.Float64()
+	return dot, absDot
+}
@@ -0,0 +1,146 @@
+package palgo
+
+import (
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// Windows returns every window of the given size in arr, starting one every
+// step items. See algo.Windows for details.
+//
+// Complexity is O(|arr|/step).
+func Windows[T any](arr []T, size, step int) [][]T {
+	return WindowsWith(DefaultExecutor, arr, size, step)
+}
+
+// WindowsWith is the same as Windows, but it distributes the work with the given executor.
+func WindowsWith[T any](exec Executor, arr []T, size, step int) [][]T {
+	if size <= 0 || step <= 0 || len(arr) < size {
+		return algo.Windows(arr, size, step)
+	}
+	return GenerateIndexedWith(exec, (len(arr)-size)/step+1, func(i int) []T {
+		return arr[i*step : i*step+size : i*step+size]
+	})
+}
+
+// Chunk splits arr into consecutive slices of n items. See algo.Chunk for
+// details.
+//
+// Complexity is O(|arr|/n).
+func Chunk[T any](arr []T, n int) [][]T {
+	return ChunkWith(DefaultExecutor, arr, n)
+}
+
+// ChunkWith is the same as Chunk, but it distributes the work with the given executor.
+func ChunkWith[T any](exec Executor, arr []T, n int) [][]T {
+	if n <= 0 {
+		return algo.Chunk(arr, n)
+	}
+	return GenerateIndexedWith(exec, roundUpDiv(len(arr), n), func(i int) []T {
+		end := utils.Min((i+1)*n, len(arr))
+		return arr[i*n : end : end]
+	})
+}
+
+// WindowReduce slides a window of the given size across arr, one item at a
+// time, and reduces every window with fold, starting from init. See
+// algo.WindowReduce for details.
+//
+// Complexity is O(|arr|·size).
+func WindowReduce[T, O any](arr []T, size int, fold func(O, T) O, init O) []O {
+	return WindowReduceWith(DefaultExecutor, arr, size, fold, init)
+}
+
+// WindowReduceWith is the same as WindowReduce, but it distributes the work with the given executor.
+func WindowReduceWith[T, O any](exec Executor, arr []T, size int, fold func(O, T) O, init O) []O {
+	return sliding(exec, arr, size, func(sub []T) []O {
+		return algo.WindowReduce(sub, size, fold, init)
+	})
+}
+
+// SlidingMin slides a window of the given size across arr, one item at a
+// time, and returns the minimum of every window. See algo.SlidingMin for
+// details.
+//
+// Complexity is O(|arr|).
+func SlidingMin[T constraints.Ordered](arr []T, size int) []T {
+	return SlidingMinWith(DefaultExecutor, arr, size)
+}
+
+// SlidingMinWith is the same as SlidingMin, but it distributes the work with the given executor.
+func SlidingMinWith[T constraints.Ordered](exec Executor, arr []T, size int) []T {
+	return sliding(exec, arr, size, func(sub []T) []T {
+		return algo.SlidingMin(sub, size)
+	})
+}
+
+// SlidingMax slides a window of the given size across arr, one item at a
+// time, and returns the maximum of every window. See algo.SlidingMax for
+// details.
+//
+// Complexity is O(|arr|).
+func SlidingMax[T constraints.Ordered](arr []T, size int) []T {
+	return SlidingMaxWith(DefaultExecutor, arr, size)
+}
+
+// SlidingMaxWith is the same as SlidingMax, but it distributes the work with the given executor.
+func SlidingMaxWith[T constraints.Ordered](exec Executor, arr []T, size int) []T {
+	return sliding(exec, arr, size, func(sub []T) []T {
+		return algo.SlidingMax(sub, size)
+	})
+}
+
+// RollingMean slides a window of the given size across arr, one item at a
+// time, and returns the mean of every window. See algo.RollingMean for
+// details.
+//
+// Complexity is O(|arr|).
+func RollingMean[T utils.Number](arr []T, size int) []float64 {
+	return RollingMeanWith(DefaultExecutor, arr, size)
+}
+
+// RollingMeanWith is the same as RollingMean, but it distributes the work with the given executor.
+func RollingMeanWith[T utils.Number](exec Executor, arr []T, size int) []float64 {
+	return sliding(exec, arr, size, func(sub []T) []float64 {
+		return algo.RollingMean(sub, size)
+	})
+}
+
+// RollingVariance slides a window of the given size across arr, one item at
+// a time, and returns the population variance of every window. See
+// algo.RollingVariance for details.
+//
+// Complexity is O(|arr|).
+func RollingVariance[T utils.Number](arr []T, size int) []float64 {
+	return RollingVarianceWith(DefaultExecutor, arr, size)
+}
+
+// RollingVarianceWith is the same as RollingVariance, but it distributes the work with the given executor.
+func RollingVarianceWith[T utils.Number](exec Executor, arr []T, size int) []float64 {
+	return sliding(exec, arr, size, func(sub []T) []float64 {
+		return algo.RollingVariance(sub, size)
+	})
+}
+
+// sliding computes a value for every window of the given size in arr, with
+// a function f that does so for every window in a sub-array. The windows are
+// split among the workers, and every worker calls f with the items of all its
+// windows, including the ones past the end of its chunk.
+func sliding[T, O any](exec Executor, arr []T, size int, f func([]T) []O) []O {
+	if size <= 0 || len(arr) < size {
+		return f(arr)
+	}
+
+	nWindows := len(arr) - size + 1
+	dist := exec.distribute(nWindows, 3)
+	if dist.NWorkers() < 2 {
+		return f(arr)
+	}
+
+	out := make([]O, nWindows)
+	dist.Run(func(w WorkAlloc) {
+		copy(out[w.Begin:w.End], f(arr[w.Begin:w.End+size-1]))
+	})
+	return out
+}
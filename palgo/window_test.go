@@ -0,0 +1,54 @@
+package palgo_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindows(t *testing.T) {
+	t.Parallel()
+
+	executors := testExecutors()
+
+	for name, exec := range executors {
+		for _, size := range []int{0, 1, 5, 100, 1000} {
+			for _, window := range []int{1, 2, 7, 50} {
+				t.Run(fmt.Sprintf("%s, size %d, window %d", name, size, window), func(t *testing.T) {
+					t.Parallel()
+
+					rng := rand.New(rand.NewSource(int64(size * window))) //nolint: gosec // Deterministic tests.
+					input := algo.Generate(size, func() int { return rng.Intn(1000) })
+
+					// Windows straddling the boundaries between chunks must be computed too.
+					require.Equal(t, algo.Windows(input, window, 3), palgo.WindowsWith(exec, input, window, 3), "Wrong WindowsWith")
+					require.Equal(t, algo.Chunk(input, window), palgo.ChunkWith(exec, input, window), "Wrong ChunkWith")
+					require.Equal(t, algo.WindowReduce(input, window, utils.Add[int], 0), palgo.WindowReduceWith(exec, input, window, utils.Add[int], 0), "Wrong WindowReduceWith")
+					require.Equal(t, algo.SlidingMin(input, window), palgo.SlidingMinWith(exec, input, window), "Wrong SlidingMinWith")
+					require.Equal(t, algo.SlidingMax(input, window), palgo.SlidingMaxWith(exec, input, window), "Wrong SlidingMaxWith")
+
+					requireInDeltaSlice(t, algo.RollingMean(input, window), palgo.RollingMeanWith(exec, input, window), 1e-9, "Wrong RollingMeanWith")
+					requireInDeltaSlice(t, algo.RollingVariance(input, window), palgo.RollingVarianceWith(exec, input, window), 1e-9, "Wrong RollingVarianceWith")
+				})
+			}
+		}
+	}
+
+	require.Panics(t, func() { palgo.SlidingMin(make([]int, 100), 0) })
+	require.Panics(t, func() { palgo.Windows(make([]int, 100), 1, 0) })
+}
+
+func requireInDeltaSlice(t *testing.T, want, got []float64, delta float64, msg string) {
+	t.Helper()
+	require.Len(t, got, len(want), msg)
+	for i := range want {
+		require.False(t, math.IsNaN(got[i]), "%s: NaN at index %d", msg, i)
+		require.InDelta(t, want[i], got[i], delta, "%s: mismatch at index %d", msg, i)
+	}
+}
@@ -1,7 +1,10 @@
 package palgo
 
 import (
+	"context"
+	"errors"
 	"runtime"
+	"runtime/debug"
 	"sync"
 
 	"github.com/EduardGomezEscandell/algo/utils"
@@ -10,11 +13,23 @@ import (
 // WorkDistribution is an struct containing information regarding workers.
 type WorkDistribution struct {
 	Work []WorkAlloc
+
+	// pool runs the workers. If nil, new goroutines are launched instead.
+	pool *Pool
+
+	// schedule and minChunk determine how runChunks splits the work.
+	schedule Schedule
+	minChunk int
 }
 
 // NewWorkDistribution takes a total workload and a minimum workload per worker
-// and distributes it along as many workers as possible, up to the number of cores.
+// and distributes it along as many workers as possible, up to GOMAXPROCS.
 func NewWorkDistribution(workload, minWorkload int) WorkDistribution {
+	return newWorkDistribution(workload, minWorkload, runtime.GOMAXPROCS(0))
+}
+
+// newWorkDistribution is the same as NewWorkDistribution, with up to maxWorkers workers.
+func newWorkDistribution(workload, minWorkload, maxWorkers int) WorkDistribution {
 	if workload < minWorkload {
 		return WorkDistribution{Work: []WorkAlloc{{
 			Begin: 0,
@@ -22,7 +37,7 @@ func NewWorkDistribution(workload, minWorkload int) WorkDistribution {
 		}}}
 	}
 
-	chunkSize := utils.Max(minWorkload, roundUpDiv(workload, runtime.NumCPU()))
+	chunkSize := utils.Max(minWorkload, roundUpDiv(workload, maxWorkers))
 	nWorkers := roundUpDiv(workload, chunkSize)
 
 	w := make([]WorkAlloc, nWorkers)
@@ -49,19 +64,88 @@ func (dist WorkDistribution) NWorkers() int {
 	return len(dist.Work)
 }
 
-// Run executes one the function in each of its goroutines.
+// Run executes the function in each of its goroutines. If any of them
+// panics, the panic is raised again in the calling goroutine once all of
+// them are done, as a *PanicError with the original value and stack. Use
+// RunErr to get it as an error instead.
 func (dist WorkDistribution) Run(f func(WorkAlloc)) {
+	repanic(dist.RunErr(context.Background(), func(_ context.Context, w WorkAlloc) error {
+		f(w)
+		return nil
+	}))
+}
+
+// RunErr executes the function in each of its goroutines. The first error
+// cancels the context passed to the rest of them. Panics inside the goroutines
+// are recovered, and treated as errors of type PanicError.
+//
+// If any goroutine fails, the errors are returned as an AggregateError in order
+// of worker ID. Errors caused by the cancellation are left out, unless the
+// parent context was cancelled too.
+func (dist WorkDistribution) RunErr(parent context.Context, f func(context.Context, WorkAlloc) error) error {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	errs := make([]error, len(dist.Work))
 	var wg sync.WaitGroup
+	var inline []func()
 	for _, chunk := range dist.Work {
 		wg.Add(1)
 		chunk := chunk
-		go func() {
+		task := func() {
 			defer wg.Done()
-			f(chunk)
-		}()
+			defer func() {
+				if r := recover(); r != nil {
+					errs[chunk.WorkerID] = &PanicError{WorkerID: chunk.WorkerID, Value: r, Stack: debug.Stack()}
+				}
+				if errs[chunk.WorkerID] != nil {
+					cancel()
+				}
+			}()
+			errs[chunk.WorkerID] = f(ctx, chunk)
+		}
+		if !dist.pool.tryGo(task) {
+			inline = append(inline, task)
+		}
+	}
+
+	// The work that no goroutine could take is done here.
+	for _, task := range inline {
+		task()
 	}
 
 	wg.Wait()
+
+	var agg, cancelled AggregateError
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case parent.Err() == nil && errors.Is(err, context.Canceled):
+			cancelled = append(cancelled, err)
+		default:
+			agg = append(agg, err)
+		}
+	}
+	if len(agg) == 0 {
+		agg = cancelled
+	}
+	if len(agg) == 0 {
+		return nil
+	}
+	return agg
+}
+
+// repanic raises again the first panic in an error returned by RunErr, for
+// functions that cannot fail otherwise.
+func repanic(err error) {
+	if err == nil {
+		return
+	}
+	var perr *PanicError
+	if errors.As(err, &perr) {
+		panic(perr)
+	}
+	panic(err)
 }
 
 // WorkAlloc represents the work to be done by a single worker.
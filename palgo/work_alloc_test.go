@@ -0,0 +1,121 @@
+package palgo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/palgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPanics(t *testing.T) {
+	t.Parallel()
+
+	dist := palgo.NewWorkDistribution(100, 3)
+	f := func(w palgo.WorkAlloc) {
+		if w.Begin <= 42 && 42 < w.End {
+			panic("something went wrong")
+		}
+	}
+
+	// Run raises the panic again in the calling goroutine.
+	var perr *palgo.PanicError
+	func() {
+		defer func() { perr, _ = recover().(*palgo.PanicError) }()
+		dist.Run(f)
+	}()
+	require.NotNil(t, perr, "Run should have raised the panic again as a PanicError")
+	require.Equal(t, "something went wrong", perr.Value)
+	require.NotEmpty(t, perr.Stack)
+
+	// RunErr returns it as an error instead.
+	err := dist.RunErr(context.Background(), func(_ context.Context, w palgo.WorkAlloc) error {
+		f(w)
+		return nil
+	})
+	require.Error(t, err, "RunErr should have returned the panic as an error")
+	require.ErrorAs(t, err, &perr, "RunErr should have returned a PanicError")
+	require.Equal(t, "something went wrong", perr.Value)
+	require.NotEmpty(t, perr.Stack)
+	require.Contains(t, err.Error(), "something went wrong")
+
+	require.NotPanics(t, func() { dist.Run(func(palgo.WorkAlloc) {}) })
+}
+
+func TestRunErr(t *testing.T) {
+	t.Parallel()
+
+	errFirst := errors.New("first error")
+	errOther := errors.New("other error")
+
+	testCases := map[string]struct {
+		f       func(context.Context, palgo.WorkAlloc) error
+		cancel  bool
+		wantErr []error
+	}{
+		"no errors": {f: func(context.Context, palgo.WorkAlloc) error { return nil }},
+		"one error": {
+			f: func(_ context.Context, w palgo.WorkAlloc) error {
+				if w.WorkerID == 0 {
+					return errFirst
+				}
+				return nil
+			},
+			wantErr: []error{errFirst},
+		},
+		"every worker fails": {
+			f: func(_ context.Context, w palgo.WorkAlloc) error {
+				if w.WorkerID == 0 {
+					return errFirst
+				}
+				return errOther
+			},
+			wantErr: []error{errFirst},
+		},
+		"cancellation is not reported": {
+			f: func(ctx context.Context, w palgo.WorkAlloc) error {
+				if w.WorkerID == 0 {
+					return errFirst
+				}
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			wantErr: []error{errFirst},
+		},
+		"parent cancellation": {
+			f: func(ctx context.Context, w palgo.WorkAlloc) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			cancel:  true,
+			wantErr: []error{context.Canceled},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancel {
+				cancel()
+			}
+
+			err := palgo.NewWorkDistribution(100, 3).RunErr(ctx, tc.f)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tc.wantErr {
+				require.ErrorIs(t, err, want)
+			}
+			if !tc.cancel {
+				require.NotErrorIs(t, err, context.Canceled, "Cancellation errors should be left out")
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Package seq implements lazy versions of the algorithms in algo. Instead of
+// slices, they take and return iterators, so chaining them does not allocate
+// intermediate slices. Items are only computed when they are consumed.
+//
+// The iterators are the standard iter.Seq and iter.Seq2, so they can be used
+// in range loops, and with any other package that uses them. Use Values and
+// Collect to convert from and to slices.
+package seq
+
+import (
+	"iter"
+
+	"github.com/EduardGomezEscandell/algo/utils"
+)
+
+// Values returns a sequence of the items in arr, in order.
+func Values[T any](arr []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, t := range arr {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Generate returns an infinite sequence, where item i is the result of
+// the i-th call to f. Use Take to limit its length.
+func Generate[T any](f func() T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			if !yield(f()) {
+				return
+			}
+		}
+	}
+}
+
+// Collect stores all items in the sequence into a new slice.
+func Collect[T any](s iter.Seq[T]) []T {
+	out := []T{}
+	for t := range s {
+		out = append(out, t)
+	}
+	return out
+}
+
+// Reduce applies the function fold:OxT->O cummulatively, starting with the
+// initial value init. The end result is equivalent to:
+//
+//	fold(fold(...fold(init, s[0]), ..., s[n-2]), s[n-1])
+//
+// where n is the length of the sequence.
+func Reduce[T, O any](s iter.Seq[T], fold func(O, T) O, init O) O {
+	acc := init
+	for t := range s {
+		acc = fold(acc, t)
+	}
+	return acc
+}
+
+// First returns the first item in the sequence. The boolean is false if the
+// sequence is empty.
+func First[T any](s iter.Seq[T]) (T, bool) {
+	for t := range s {
+		return t, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Count returns the number of items in the sequence.
+func Count[T any](s iter.Seq[T]) int {
+	return Reduce(s, func(n int, _ T) int { return n + 1 }, 0)
+}
+
+// Map applies function f:T->O lazily to every item in the sequence.
+func Map[T, O any](s iter.Seq[T], f func(T) O) iter.Seq[O] {
+	return func(yield func(O) bool) {
+		for t := range s {
+			if !yield(f(t)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence with only the items that fulfil the predicate.
+func Filter[T any](s iter.Seq[T], pred utils.Predicate[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for t := range s {
+			if pred(t) && !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap applies function f:T->Seq[O] lazily to every item in the sequence,
+// and concatenates the resulting sequences.
+func FlatMap[T, O any](s iter.Seq[T], f func(T) iter.Seq[O]) iter.Seq[O] {
+	return func(yield func(O) bool) {
+		for t := range s {
+			for o := range f(t) {
+				if !yield(o) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Take returns a sequence with the first n items of s, or all of them if
+// there are fewer. It panics if n is negative.
+func Take[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	validateCount(n)
+	return func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		i := 0
+		for t := range s {
+			if !yield(t) {
+				return
+			}
+			if i++; i == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence without the first n items of s. It panics if n is
+// negative.
+func Skip[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	validateCount(n)
+	return func(yield func(T) bool) {
+		i := 0
+		for t := range s {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Stride returns a sequence with one item every n of them, starting with
+// the first one. It panics if n is not positive.
+func Stride[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	validateSize(n)
+	return func(yield func(T) bool) {
+		i := 0
+		for t := range s {
+			if i%n == 0 && !yield(t) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Enumerate pairs every item in the sequence with its index.
+func Enumerate[T any](s iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for t := range s {
+			if !yield(i, t) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Zip pairs the items of two sequences with the same index. It stops at the
+// end of the shortest one.
+func Zip[L, R any](first iter.Seq[L], second iter.Seq[R]) iter.Seq2[L, R] {
+	return func(yield func(L, R) bool) {
+		next, stop := iter.Pull(second)
+		defer stop()
+
+		for l := range first {
+			r, ok := next()
+			if !ok || !yield(l, r) {
+				return
+			}
+		}
+	}
+}
+
+// ZipWith applies zip:LxR->O lazily to the items of two sequences with the
+// same index. It stops at the end of the shortest one.
+func ZipWith[L, R, O any](first iter.Seq[L], second iter.Seq[R], zip func(L, R) O) iter.Seq[O] {
+	return func(yield func(O) bool) {
+		for l, r := range Zip(first, second) {
+			if !yield(zip(l, r)) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk splits the sequence into consecutive slices of n items. The last one
+// is shorter if the length of the sequence is not a multiple of n. Every
+// chunk is a new slice. It panics if n is not positive.
+func Chunk[T any](s iter.Seq[T], n int) iter.Seq[[]T] {
+	validateSize(n)
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		for t := range s {
+			chunk = append(chunk, t)
+			if len(chunk) < n {
+				continue
+			}
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]T, 0, n)
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Window slides a window of n items across the sequence, one item at a time.
+// Sequences shorter than n produce no windows. Every window is a new slice.
+// It panics if n is not positive.
+func Window[T any](s iter.Seq[T], n int) iter.Seq[[]T] {
+	validateSize(n)
+	return func(yield func([]T) bool) {
+		window := make([]T, 0, n)
+		for t := range s {
+			if len(window) == n {
+				next := make([]T, n-1, n)
+				copy(next, window[1:])
+				window = next
+			}
+			window = append(window, t)
+			if len(window) == n && !yield(window) {
+				return
+			}
+		}
+	}
+}
+
+func validateCount(n int) {
+	if n < 0 {
+		panic("count must not be negative")
+	}
+}
+
+func validateSize(n int) {
+	if n <= 0 {
+		panic("size must be positive")
+	}
+}
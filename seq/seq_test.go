@@ -0,0 +1,183 @@
+package seq_test
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/EduardGomezEscandell/algo/algo"
+	"github.com/EduardGomezEscandell/algo/seq"
+	"github.com/EduardGomezEscandell/algo/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapters(t *testing.T) {
+	t.Parallel()
+
+	input := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	even := func(x int) bool { return x%2 == 0 }
+	double := func(x int) int { return 2 * x }
+
+	testCases := map[string]struct {
+		got  iter.Seq[int]
+		want []int
+	}{
+		"Values":         {got: seq.Values(input), want: input},
+		"Values, empty":  {got: seq.Values([]int{}), want: []int{}},
+		"Generate":       {got: seq.Take(seq.Generate(func() int { return 5 }), 3), want: []int{5, 5, 5}},
+		"Map":            {got: seq.Map(seq.Values(input), double), want: algo.Map(input, double)},
+		"Filter":         {got: seq.Filter(seq.Values(input), even), want: algo.Filter(input, even)},
+		"Take":           {got: seq.Take(seq.Values(input), 3), want: []int{0, 1, 2}},
+		"Take none":      {got: seq.Take(seq.Values(input), 0), want: []int{}},
+		"Take too many":  {got: seq.Take(seq.Values(input), 20), want: input},
+		"Skip":           {got: seq.Skip(seq.Values(input), 7), want: []int{7, 8, 9}},
+		"Skip none":      {got: seq.Skip(seq.Values(input), 0), want: input},
+		"Skip too many":  {got: seq.Skip(seq.Values(input), 20), want: []int{}},
+		"Stride":         {got: seq.Stride(seq.Values(input), 3), want: algo.Stride(input, 3)},
+		"Stride of one":  {got: seq.Stride(seq.Values(input), 1), want: input},
+		"FlatMap":        {got: seq.FlatMap(seq.Values([]int{1, 2, 3}), func(x int) iter.Seq[int] { return seq.Take(seq.Generate(func() int { return x }), x) }), want: []int{1, 2, 2, 3, 3, 3}},
+		"ZipWith":        {got: seq.ZipWith(seq.Values(input), seq.Values(input[3:]), utils.Add[int]), want: algo.ZipWith(input, input[3:], utils.Add[int])},
+		"ZipWith, empty": {got: seq.ZipWith(seq.Values(input), seq.Values([]int{}), utils.Add[int]), want: []int{}},
+		"Chained":        {got: seq.Stride(seq.Filter(seq.Map(seq.Values(input), double), func(x int) bool { return x > 4 }), 2), want: []int{6, 10, 14, 18}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, seq.Collect(tc.got))
+
+			// Sequences can be iterated more than once.
+			require.Equal(t, tc.want, seq.Collect(tc.got))
+
+			// Stopping early must not iterate any further.
+			if len(tc.want) > 0 {
+				first, ok := seq.First(tc.got)
+				require.True(t, ok)
+				require.Equal(t, tc.want[0], first)
+			}
+		})
+	}
+}
+
+func TestSlidingAdapters(t *testing.T) {
+	t.Parallel()
+
+	input := []int{0, 1, 2, 3, 4}
+
+	testCases := map[string]struct {
+		got  iter.Seq[[]int]
+		want [][]int
+	}{
+		"Chunk":                {got: seq.Chunk(seq.Values(input), 2), want: [][]int{{0, 1}, {2, 3}, {4}}},
+		"Chunk, exact":         {got: seq.Chunk(seq.Values(input), 5), want: [][]int{{0, 1, 2, 3, 4}}},
+		"Chunk, empty":         {got: seq.Chunk(seq.Values([]int{}), 5), want: [][]int{}},
+		"Window":               {got: seq.Window(seq.Values(input), 3), want: [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}},
+		"Window of one":        {got: seq.Window(seq.Values(input), 1), want: [][]int{{0}, {1}, {2}, {3}, {4}}},
+		"Window, too short":    {got: seq.Window(seq.Values(input), 6), want: [][]int{}},
+		"Window, whole thing":  {got: seq.Window(seq.Values(input), 5), want: [][]int{input}},
+		"Chunk of Windows":     {got: seq.Map(seq.Chunk(seq.Window(seq.Values(input), 2), 2), func(w [][]int) []int { return w[0] }), want: [][]int{{0, 1}, {2, 3}}},
+		"Take Chunk, infinite": {got: seq.Take(seq.Chunk(seq.Generate(counter(0)), 2), 2), want: [][]int{{0, 1}, {2, 3}}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Slices must not be modified after they are yielded.
+			require.Equal(t, tc.want, seq.Collect(tc.got))
+		})
+	}
+}
+
+func TestPairAdapters(t *testing.T) {
+	t.Parallel()
+
+	var indices []int
+	var words []string
+	for i, w := range seq.Enumerate(seq.Values([]string{"a", "b", "c"})) {
+		indices = append(indices, i)
+		words = append(words, w)
+	}
+	require.Equal(t, []int{0, 1, 2}, indices)
+	require.Equal(t, []string{"a", "b", "c"}, words)
+
+	var pairs []string
+	for n, w := range seq.Zip(seq.Generate(counter(1)), seq.Values([]string{"a", "b"})) {
+		pairs = append(pairs, w+string(rune('0'+n)))
+	}
+	require.Equal(t, []string{"a1", "b2"}, pairs)
+
+	for range seq.Zip(seq.Values([]int{1, 2}), seq.Generate(counter(0))) {
+		break
+	}
+}
+
+func TestTerminals(t *testing.T) {
+	t.Parallel()
+
+	input := []int{3, 1, 4, 1, 5}
+
+	require.Equal(t, algo.Reduce(input, utils.Add[int], 2), seq.Reduce(seq.Values(input), utils.Add[int], 2))
+	require.Equal(t, 7, seq.Reduce(seq.Values([]int{}), utils.Add[int], 7))
+	require.Equal(t, 5, seq.Count(seq.Values(input)))
+	require.Equal(t, 0, seq.Count(seq.Values([]int{})))
+
+	first, ok := seq.First(seq.Filter(seq.Generate(counter(0)), func(x int) bool { return x > 41 }))
+	require.True(t, ok)
+	require.Equal(t, 42, first)
+
+	_, ok = seq.First(seq.Values([]int{}))
+	require.False(t, ok)
+}
+
+func TestLaziness(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	square := func(x int) int { calls++; return x * x }
+
+	s := seq.Map(seq.Generate(counter(0)), square)
+	require.Zero(t, calls, "Nothing should be computed before the sequence is consumed")
+
+	require.Equal(t, []int{0, 1, 4}, seq.Collect(seq.Take(s, 3)))
+	require.Equal(t, 3, calls, "Only the consumed items should be computed")
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+
+	s := seq.Values([]int{1, 2, 3})
+	require.Panics(t, func() { seq.Take(s, -1) })
+	require.Panics(t, func() { seq.Skip(s, -1) })
+	require.Panics(t, func() { seq.Stride(s, 0) })
+	require.Panics(t, func() { seq.Chunk(s, 0) })
+	require.Panics(t, func() { seq.Window(s, -2) })
+}
+
+func BenchmarkChain(b *testing.B) {
+	input := algo.Generate(100_000, counter(0))
+	double := func(x int) int { return 2 * x }
+	pred := func(x int) bool { return x%3 != 0 }
+
+	b.Run("algo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			algo.Reduce(algo.Stride(algo.Filter(algo.Map(input, double), pred), 2), utils.Add[int], 0)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			seq.Reduce(seq.Stride(seq.Filter(seq.Map(seq.Values(input), double), pred), 2), utils.Add[int], 0)
+		}
+	})
+}
+
+// counter returns a generator of the sequence start, start+1, start+2, ...
+func counter(start int) func() int {
+	next := start
+	return func() int {
+		next++
+		return next - 1
+	}
+}